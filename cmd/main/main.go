@@ -14,6 +14,10 @@ package main
 // @in header
 // @name Authorization
 
+// @securityDefinitions.apikey APIKeyAuth
+// @in header
+// @name X-API-Key
+
 import (
 	"context"
 	"net/http"
@@ -30,6 +34,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/auth"
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/router"
@@ -72,6 +77,10 @@ func main() {
 				return true
 			}
 
+			if router.HttpIsStream(c) {
+				return true
+			}
+
 			return false
 		},
 	}))
@@ -79,7 +88,7 @@ func main() {
 	// Router CORS
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{router.CORSOrigin},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, auth.AuthAPIKeyHeader},
 		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.PATCH, echo.DELETE},
 	}))
 
@@ -37,21 +37,2335 @@ const docTemplate = `{
                 }
             }
         },
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Get Public Keys Used to Verify Authentication Token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Get JSON Web Key Set",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/apikey": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Every API Key Bound to WhatsApp Account",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp API Key"
+                ],
+                "summary": "Get API Keys",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Create Long-Lived API Key Bound to WhatsApp Account, The Key is Only Shown Once",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp API Key"
+                ],
+                "summary": "Create API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API Key Name",
+                        "name": "name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma Separated Scopes (send, group:read, group:write, session:admin, events:read)",
+                        "name": "scopes",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/apikey/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Revoke API Key Bound to WhatsApp Account",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp API Key"
+                ],
+                "summary": "Revoke API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
         "/auth": {
             "get": {
                 "security": [
                     {
-                        "BasicAuth": []
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get Authentication Token for JID That is Allowed to be Controlled by User",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Generate Authentication Token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JID to Control, Required for Administrator or When User is Allowed to Control More Than One JID",
+                        "name": "jid",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Exchange Refresh Token With New Access Token and Refresh Token, Every Refresh Token Can Only Be Used Once",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Refresh Authentication Token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refresh Token",
+                        "name": "refresh_token",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/auth/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Revoke Current Token Session, Session of Given Refresh Token, or Every Token Issued for JID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Revoke Authentication Token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refresh Token to Revoke Instead of Current Token",
+                        "name": "refresh_token",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Revoke Every Token Issued for JID",
+                        "name": "all",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/auth/users": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get Every User in Credential Store With Allowed JIDs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Get All Users",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Create User in Credential Store With Allowed JIDs",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Create User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Password, Minimum 8 Characters",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma Separated JIDs That Allowed to be Controlled",
+                        "name": "jids",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/auth/users/{username}": {
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Delete User From Credential Store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Delete User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/auth/users/{username}/disable": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Disable User So It Can Not Generate Authentication Token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Disable User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/auth/users/{username}/enable": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Enable Previously Disabled User",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Enable User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/auth/users/{username}/jids": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Replace JIDs That Allowed to be Controlled by User",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Set User Allowed JIDs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma Separated JIDs That Allowed to be Controlled",
+                        "name": "jids",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/auth/users/{username}/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Replace User Password, Random Password Will Be Generated if Empty",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Root"
+                ],
+                "summary": "Rotate User Password",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "New Password, Minimum 8 Characters",
+                        "name": "password",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/community": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Create Community With Optional Initial Participants, Announcement Group is Created Automatically",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Community"
+                ],
+                "summary": "Create Community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community Subject",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Participant WhatsApp Personal IDs (Comma Separated or Repeated)",
+                        "name": "participants",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/community/{cid}/announce": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Send Message to Community Announcement Group By Community ID\nOther Form Values Are The Same as The Send Message Endpoint of Selected Type",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Community"
+                ],
+                "summary": "Send Community Announcement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "cid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "location",
+                            "link",
+                            "poll",
+                            "contact",
+                            "document",
+                            "image",
+                            "audio",
+                            "video",
+                            "sticker"
+                        ],
+                        "type": "string",
+                        "default": "text",
+                        "description": "Message Type",
+                        "name": "type",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text Message",
+                        "name": "message",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/community/{cid}/link": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Link Existing Group to Community By Community ID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Community"
+                ],
+                "summary": "Link Group to Community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "cid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupid",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/community/{cid}/subgroups": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Groups Linked to Community Including Its Announcement Group",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Community"
+                ],
+                "summary": "Get Community Linked Groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "cid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/community/{cid}/unlink": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Unlink Group From Community By Community ID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Community"
+                ],
+                "summary": "Unlink Group From Community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "cid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupid",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/events/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Stream Live WhatsApp Events, Reset Event is Sent First When Events After Last Event ID Can Not Be Replayed",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "WhatsApp Event"
+                ],
+                "summary": "Stream WhatsApp Events Using Server-Sent Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma Separated Event Type Filter",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma Separated Chat WhatsApp Personal ID or Group ID Filter",
+                        "name": "chat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume Stream After Event ID",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/events/ws": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Stream Live WhatsApp Events, Token Can Also Be Sent Using Query Parameter Since Browser WebSocket Can Not Set Header",
+                "tags": [
+                    "WhatsApp Event"
+                ],
+                "summary": "Stream WhatsApp Events Using WebSocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma Separated Event Type Filter",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma Separated Chat WhatsApp Personal ID or Group ID Filter",
+                        "name": "chat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume Stream After Event ID",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JWT Token",
+                        "name": "token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Joined Groups Information from WhatsApp\nWithout Any Query Parameter Full Group Information List is Returned\nWith Any Query Parameter Group Summary Page Sorted by Group ID is Returned\nCommunity Hierarchy is Shown by Parent Flag and Parent Community ID of Each Group Summary",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Get Joined Groups Information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search Group Subject",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only Groups Where Account is Admin",
+                        "name": "admin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only Groups Linked to Community ID",
+                        "name": "parent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor From Previous Page Next Cursor",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Size (Default 50, Maximum 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Create Group With Initial Participants, Result Code is Returned for Each Participant",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Create Group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group Subject",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Participant WhatsApp Personal IDs (Comma Separated or Repeated)",
+                        "name": "participants",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Community ID to Create Group Inside Community",
+                        "name": "parent",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/invite/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Group Subject, Size and Owner From Invitation Link Without Joining\nDirect Invitation Message Can be Previewed Using Group ID, Inviter, Code and Expiration Instead of Link",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Preview Group From Invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group Invitation Link",
+                        "name": "link",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID From Invitation Message",
+                        "name": "groupid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Inviter WhatsApp Personal ID From Invitation Message",
+                        "name": "inviter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invite Code From Invitation Message",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Invite Expiration Unix Timestamp From Invitation Message",
+                        "name": "expiration",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/join": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Joining to Group From Invitation Link from WhatsApp",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Join Group From Invitation Link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group Invitation Link",
+                        "name": "link",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/join/invite": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Joining to Group From Direct Invitation Message Using Invite Code and Expiration",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Join Group From Invitation Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID From Invitation Message",
+                        "name": "groupid",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Inviter WhatsApp Personal ID From Invitation Message",
+                        "name": "inviter",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invite Code From Invitation Message",
+                        "name": "code",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Invite Expiration Unix Timestamp From Invitation Message",
+                        "name": "expiration",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/leave": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Leaving Group By Group ID from WhatsApp",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Leave Group By Group ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupid",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Group Information By Group ID Including Participants With Admin Flags and LID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Get Group Information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/announce": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Toggle Group Announce Mode By Group ID, Only Admins Can Send Message When Enabled",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Toggle Group Announce Mode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Enable Announce Mode",
+                        "name": "enabled",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/description": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Change Group Description By Group ID, Empty Description Removes It",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Change Group Description",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group Description",
+                        "name": "description",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/disappearing": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Set Group Disappearing Message Timer By Group ID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Set Group Disappearing Message Timer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Disappearing Timer (off, 24h, 7d, 90d)",
+                        "name": "timer",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Group Membership and Setting Changes Recorded While Account is in The Group\nOrdered From The Newest Entry, Use Next Cursor as Before Cursor for Next Page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Get Group History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "joined",
+                            "join",
+                            "add",
+                            "leave",
+                            "remove",
+                            "promote",
+                            "demote",
+                            "subject",
+                            "description",
+                            "locked",
+                            "announce",
+                            "disappearing",
+                            "invite_link",
+                            "link",
+                            "unlink",
+                            "delete"
+                        ],
+                        "type": "string",
+                        "description": "Filter by Action",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Cursor From Previous Page Next Cursor",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Size (Default 50, Maximum 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/invite": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Current Group Invitation Link By Group ID, Only Group Admin is Allowed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Get Group Invitation Link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/invite/reset": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Revoke Current Group Invitation Link and Generate New One By Group ID, Only Group Admin is Allowed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Reset Group Invitation Link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/locked": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Toggle Group Locked Mode By Group ID, Only Admins Can Edit Group Information When Enabled",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Toggle Group Locked Mode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Enable Locked Mode",
+                        "name": "enabled",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/participants/add": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Add Participants to Group, Result Code is Returned for Each Participant\nParticipant With Privacy Settings Returns Code 403 With Invite Code",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Add Group Participants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Participant WhatsApp Personal IDs (Comma Separated or Repeated)",
+                        "name": "participants",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/participants/demote": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Demote Participants From Group Admin, Result Code is Returned for Each Participant",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Demote Group Participants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Participant WhatsApp Personal IDs (Comma Separated or Repeated)",
+                        "name": "participants",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/participants/promote": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Promote Participants to Group Admin, Result Code is Returned for Each Participant",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Promote Group Participants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Participant WhatsApp Personal IDs (Comma Separated or Repeated)",
+                        "name": "participants",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/participants/remove": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Remove Participants From Group, Result Code is Returned for Each Participant",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Remove Group Participants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Participant WhatsApp Personal IDs (Comma Separated or Repeated)",
+                        "name": "participants",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/photo": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Change Group Photo By Group ID, Photo is Cropped to Square JPEG",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Change Group Photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Group Photo",
+                        "name": "photo",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Remove Group Photo By Group ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Remove Group Photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Pending Join Requests By Group ID for Group in Membership Approval Mode",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Get Group Join Requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/requests/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Approve Pending Join Requests By Group ID, Result Code is Returned for Each Requester",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Approve Group Join Requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Requester JIDs (Comma Separated or Repeated)",
+                        "name": "participants",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/requests/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Reject Pending Join Requests By Group ID, Result Code is Returned for Each Requester",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Reject Group Join Requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Requester JIDs (Comma Separated or Repeated)",
+                        "name": "participants",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/group/{gid}/subject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Change Group Subject By Group ID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Group"
+                ],
+                "summary": "Change Group Subject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "gid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group Subject",
+                        "name": "subject",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get QR Code for WhatsApp Multi-Device Login",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/html"
+                ],
+                "tags": [
+                    "WhatsApp Authentication"
+                ],
+                "summary": "Generate QR Code for WhatsApp Multi-Device Login",
+                "parameters": [
+                    {
+                        "enum": [
+                            "html",
+                            "json"
+                        ],
+                        "type": "string",
+                        "default": "html",
+                        "description": "Change Output Format in HTML or JSON",
+                        "name": "output",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/login/pair": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Pairing Code for WhatsApp Multi-Device Login",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Authentication"
+                ],
+                "summary": "Pair Phone for WhatsApp Multi-Device Login",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/login/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Stream Every Rotating QR Code Using Server-Sent Events Until Success, Timeout or Error Event",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "WhatsApp Authentication"
+                ],
+                "summary": "Stream Rotating QR Code for WhatsApp Multi-Device Login",
+                "parameters": [
+                    {
+                        "enum": [
+                            "png",
+                            "svg",
+                            "ascii"
+                        ],
+                        "type": "string",
+                        "default": "png",
+                        "description": "Change QR Code Output Format",
+                        "name": "output",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Make Device Logout from WhatsApp Multi-Device",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Authentication"
+                ],
+                "summary": "Logout Device from WhatsApp Multi-Device",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/message/delete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Delete Message to Spesific WhatsApp Personal ID or Group ID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Message"
+                ],
+                "summary": "Delete Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination WhatsApp Personal ID or Group ID",
+                        "name": "msisdn",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Message ID",
+                        "name": "messageid",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/message/edit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Update Message to Spesific WhatsApp Personal ID or Group ID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Message"
+                ],
+                "summary": "Update Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination WhatsApp Personal ID or Group ID",
+                        "name": "msisdn",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Message ID",
+                        "name": "messageid",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text Message",
+                        "name": "message",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/message/react": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "React Message to Spesific WhatsApp Personal ID or Group ID",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Message"
+                ],
+                "summary": "React Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination WhatsApp Personal ID or Group ID",
+                        "name": "msisdn",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Message ID",
+                        "name": "messageid",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Message Sender WhatsApp Personal ID, Default to Own WhatsApp ID",
+                        "name": "sender",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reaction Emoji, Empty to Remove Reaction",
+                        "name": "emoji",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/message/{msgid}/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Sent Message Delivery and Read Status, Group Message Will Have Status for Every Participant",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Message"
+                ],
+                "summary": "Get Message Delivery Status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID",
+                        "name": "msgid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/messages": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Sent and Received Message History Ordered From The Newest Message",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Message"
+                ],
+                "summary": "Get Message History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat WhatsApp Personal ID or Group ID",
+                        "name": "chat",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "image",
+                            "video",
+                            "audio",
+                            "document",
+                            "sticker",
+                            "location",
+                            "contact",
+                            "poll",
+                            "poll_update",
+                            "reaction"
+                        ],
+                        "type": "string",
+                        "description": "Message Type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Get Messages Before Cursor, Use Next Cursor From Previous Page",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum Number of Messages",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/poll/{msgid}/results": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Poll Vote Tally by Poll Message ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Message"
+                ],
+                "summary": "Get Poll Results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll Message ID",
+                        "name": "msgid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/queue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Outbound Queue Jobs Ordered From The Newest Job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Queue"
+                ],
+                "summary": "Get Outbound Queue Jobs",
+                "parameters": [
+                    {
+                        "enum": [
+                            "queued",
+                            "sending",
+                            "sent",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Job State",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Get Jobs Before Cursor, Use Next Cursor From Previous Page",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum Number of Jobs",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/queue/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Get Authentication Token",
+                "description": "Get Outbound Queue Job State and Sent Message ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Root"
+                    "WhatsApp Queue"
+                ],
+                "summary": "Get Outbound Queue Job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Queue Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Generate Authentication Token",
                 "responses": {
                     "200": {
                         "description": ""
@@ -59,21 +2373,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/group": {
+        "/registered": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Get Joined Groups Information from WhatsApp",
+                "description": "Check WhatsApp Personal ID is Registered",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Group"
+                    "WhatsApp Information"
+                ],
+                "summary": "Check If WhatsApp Personal ID is Registered",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "WhatsApp Personal ID to Check",
+                        "name": "msisdn",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Joined Groups Information",
                 "responses": {
                     "200": {
                         "description": ""
@@ -81,28 +2407,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/group/join": {
+        "/registered/batch": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Joining to Group From Invitation Link from WhatsApp",
+                "description": "Check Multiple Phone Numbers Using Single Lookup, Result is Cached\nPhone Number is Normalized by Removing Space, Dash and Replacing Leading Zeros With Country Code",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Group"
+                    "WhatsApp Information"
                 ],
-                "summary": "Join Group From Invitation Link",
+                "summary": "Check If Multiple WhatsApp Personal ID Are Registered",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group Invitation Link",
-                        "name": "link",
+                        "description": "Phone Numbers to Check (Comma Separated or Repeated)",
+                        "name": "msisdn",
                         "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Default Country Code for Phone Number With Leading Zero",
+                        "name": "country_code",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -112,28 +2450,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/group/leave": {
-            "post": {
+        "/schedule": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Leaving Group By Group ID from WhatsApp",
+                "description": "Get Message Schedules Ordered From The Newest Schedule",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Group"
+                    "WhatsApp Schedule"
                 ],
-                "summary": "Leave Group By Group ID",
+                "summary": "Get Message Schedules",
                 "parameters": [
                     {
+                        "enum": [
+                            "active",
+                            "paused",
+                            "completed"
+                        ],
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupid",
-                        "in": "formData",
-                        "required": true
+                        "description": "Schedule State",
+                        "name": "state",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -141,37 +2486,77 @@ const docTemplate = `{
                         "description": ""
                     }
                 }
-            }
-        },
-        "/login": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Get QR Code for WhatsApp Multi-Device Login",
+                "description": "Schedule Any Supported Message Type to be Sent at Absolute Time or Repeatedly on Cron Expression\nMessage Form Values Are The Same as The Send Message Endpoint of Selected Type",
                 "consumes": [
                     "multipart/form-data"
                 ],
                 "produces": [
-                    "application/json",
-                    "text/html"
+                    "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Authentication"
+                    "WhatsApp Schedule"
                 ],
-                "summary": "Generate QR Code for WhatsApp Multi-Device Login",
+                "summary": "Create Message Schedule",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule Name",
+                        "name": "name",
+                        "in": "formData"
+                    },
                     {
                         "enum": [
-                            "html",
-                            "json"
+                            "text",
+                            "location",
+                            "link",
+                            "poll",
+                            "contact",
+                            "document",
+                            "image",
+                            "audio",
+                            "video",
+                            "sticker"
                         ],
                         "type": "string",
-                        "default": "html",
-                        "description": "Change Output Format in HTML or JSON",
-                        "name": "output",
+                        "description": "Message Type",
+                        "name": "type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Destination WhatsApp Personal ID or Group ID",
+                        "name": "msisdn",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Absolute Time in RFC3339 or YYYY-MM-DD HH:MM:SS Format",
+                        "name": "run_at",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standard Cron Expression (Minute Hour Day Month Weekday) or Descriptor Like @daily, Minimum Interval is One Minute",
+                        "name": "cron",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "UTC",
+                        "description": "IANA Timezone Used by Time Without Offset and Cron Expression",
+                        "name": "timezone",
                         "in": "formData"
                     }
                 ],
@@ -182,24 +2567,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/login/pair": {
-            "post": {
+        "/schedule/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Get Pairing Code for WhatsApp Multi-Device Login",
-                "consumes": [
-                    "multipart/form-data"
+                "description": "Get Message Schedule and Its Next Run Time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Schedule"
+                ],
+                "summary": "Get Message Schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
                 ],
+                "description": "Delete Message Schedule and Its Execution History",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Authentication"
+                    "WhatsApp Schedule"
+                ],
+                "summary": "Delete Message Schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Pair Phone for WhatsApp Multi-Device Login",
                 "responses": {
                     "200": {
                         "description": ""
@@ -207,21 +2633,80 @@ const docTemplate = `{
                 }
             }
         },
-        "/logout": {
+        "/schedule/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Message Schedule Executions and Their Sent Message ID Ordered From The Newest Execution",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Schedule"
+                ],
+                "summary": "Get Message Schedule History",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Get Executions Before Cursor, Use Next Cursor From Previous Page",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum Number of Executions",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/schedule/{id}/pause": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Make Device Logout from WhatsApp Multi-Device",
+                "description": "Pause Active Message Schedule",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Authentication"
+                    "WhatsApp Schedule"
+                ],
+                "summary": "Pause Message Schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Logout Device from WhatsApp Multi-Device",
                 "responses": {
                     "200": {
                         "description": ""
@@ -229,14 +2714,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/message/delete": {
+        "/schedule/{id}/resume": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Delete Message to Spesific WhatsApp Personal ID or Group ID",
+                "description": "Resume Paused Message Schedule, Missed Absolute Time Schedule is Sent Immediately",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Schedule"
+                ],
+                "summary": "Resume Message Schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/send/audio": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Send Audio Message to Spesific WhatsApp Personal ID or Group ID",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -244,9 +2766,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Message"
+                    "WhatsApp Send Message"
                 ],
-                "summary": "Delete Message",
+                "summary": "Send Audio Message",
                 "parameters": [
                     {
                         "type": "string",
@@ -256,9 +2778,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Message ID",
-                        "name": "messageid",
+                        "type": "file",
+                        "description": "Audio File",
+                        "name": "audio",
                         "in": "formData",
                         "required": true
                     }
@@ -270,14 +2792,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/message/edit": {
+        "/send/bulk": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Update Message to Spesific WhatsApp Personal ID or Group ID",
+                "description": "Send Same Message to Multiple Recipients in Background and Return Bulk Message ID\nRecipients Can be JSON Array of Object With MSISDN and Template Variables or CSV File With MSISDN Column\nMessage Text, Caption or Poll Question Can Use {{variable}} Template From Recipient Variables\nOther Form Values Are The Same as The Send Message Endpoint of Selected Type",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -285,30 +2810,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Message"
+                    "WhatsApp Send Message"
                 ],
-                "summary": "Update Message",
+                "summary": "Send Bulk Message",
                 "parameters": [
                     {
+                        "enum": [
+                            "text",
+                            "location",
+                            "link",
+                            "poll",
+                            "contact",
+                            "document",
+                            "image",
+                            "audio",
+                            "video",
+                            "sticker"
+                        ],
                         "type": "string",
-                        "description": "Destination WhatsApp Personal ID or Group ID",
-                        "name": "msisdn",
-                        "in": "formData",
-                        "required": true
+                        "default": "text",
+                        "description": "Message Type",
+                        "name": "type",
+                        "in": "formData"
                     },
                     {
                         "type": "string",
-                        "description": "Message ID",
-                        "name": "messageid",
-                        "in": "formData",
-                        "required": true
+                        "description": "JSON Array of Recipient Object With MSISDN and Template Variables",
+                        "name": "recipients",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV File of Recipients With Header Row and MSISDN Column",
+                        "name": "csv",
+                        "in": "formData"
                     },
                     {
                         "type": "string",
-                        "description": "Text Message",
+                        "description": "Text Message Template",
                         "name": "message",
-                        "in": "formData",
-                        "required": true
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -318,44 +2859,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/message/react": {
-            "post": {
+        "/send/bulk/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "React Message to Spesific WhatsApp Personal ID or Group ID",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Get Bulk Message State and Number of Recipients on Every State",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WhatsApp Message"
+                    "WhatsApp Send Message"
                 ],
-                "summary": "React Message",
+                "summary": "Get Bulk Message Progress",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Destination WhatsApp Personal ID or Group ID",
-                        "name": "msisdn",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Message ID",
-                        "name": "messageid",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Reaction Emoji",
-                        "name": "emoji",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Bulk Message ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -366,27 +2893,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/registered": {
+        "/send/bulk/{id}/csv": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Check WhatsApp Personal ID is Registered",
+                "description": "Download Bulk Message Result for Every Recipient as CSV File",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "WhatsApp Information"
+                    "WhatsApp Send Message"
                 ],
-                "summary": "Check If WhatsApp Personal ID is Registered",
+                "summary": "Download Bulk Message Recipients Result",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "WhatsApp Personal ID to Check",
-                        "name": "msisdn",
-                        "in": "query",
+                        "type": "integer",
+                        "description": "Bulk Message ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -397,38 +2927,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/send/audio": {
-            "post": {
+        "/send/bulk/{id}/recipients": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Send Audio Message to Spesific WhatsApp Personal ID or Group ID",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Get Bulk Message Result for Every Recipient Ordered as Submitted",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "WhatsApp Send Message"
                 ],
-                "summary": "Send Audio Message",
+                "summary": "Get Bulk Message Recipients Result",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Destination WhatsApp Personal ID or Group ID",
-                        "name": "msisdn",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Bulk Message ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Audio File",
-                        "name": "audio",
-                        "in": "formData",
-                        "required": true
+                        "enum": [
+                            "pending",
+                            "sending",
+                            "sent",
+                            "queued",
+                            "not_registered",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Recipient State",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Get Recipients After Cursor, Use Next Cursor From Previous Page",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum Number of Recipients",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -443,6 +2993,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Contact Message to Spesific WhatsApp Personal ID or Group ID",
@@ -466,17 +3019,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Contact Name",
+                        "description": "Contact Name (Repeat With Phone for Multiple Contacts)",
                         "name": "name",
-                        "in": "formData",
-                        "required": true
+                        "in": "formData"
                     },
                     {
                         "type": "string",
-                        "description": "Contact Phone",
+                        "description": "Contact Phone (Repeat With Name for Multiple Contacts)",
                         "name": "phone",
-                        "in": "formData",
-                        "required": true
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Raw vCard 3.0 or 4.0 Content (Can Contain Multiple Contacts)",
+                        "name": "vcard",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -491,6 +3048,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Document Message to Spesific WhatsApp Personal ID or Group ID",
@@ -532,6 +3092,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Image Message to Spesific WhatsApp Personal ID or Group ID",
@@ -587,6 +3150,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Link Message to Spesific WhatsApp Personal ID or Group ID",
@@ -634,6 +3200,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Location Message to Spesific WhatsApp Personal ID or Group ID",
@@ -682,6 +3251,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Poll to Spesific WhatsApp Personal ID or Group ID",
@@ -737,6 +3309,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Sticker Message to Spesific WhatsApp Personal ID or Group ID",
@@ -778,6 +3353,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Text Message to Spesific WhatsApp Personal ID or Group ID",
@@ -819,6 +3397,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
                 "description": "Send Video Message to Spesific WhatsApp Personal ID or Group ID",
@@ -868,9 +3449,207 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "/session": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get WhatsApp Client Connection State and Device Information",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Session"
+                ],
+                "summary": "Get Session Status",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get Connection State and Device Information of Every WhatsApp Client",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Session"
+                ],
+                "summary": "Get All Session Status",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/webhook": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Webhook Configuration for Incoming WhatsApp Event, Secret is Only Returned When Webhook is Set",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Webhook"
+                ],
+                "summary": "Get Webhook Configuration",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Set Webhook URL for Incoming WhatsApp Event, Secret Will Be Generated if Empty",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Webhook"
+                ],
+                "summary": "Set Webhook Configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook URL",
+                        "name": "url",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook HMAC-SHA256 Signing Secret",
+                        "name": "secret",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Delete Webhook Configuration for Incoming WhatsApp Event",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Webhook"
+                ],
+                "summary": "Delete Webhook Configuration",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/webhook/deadletter": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get Webhook Deliveries That Still Failed After Every Retry",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Webhook"
+                ],
+                "summary": "Get Webhook Dead-Letters",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum Number of Dead-Letters",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Remove Every Webhook Dead-Letter",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WhatsApp Webhook"
+                ],
+                "summary": "Purge Webhook Dead-Letters",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
+        "APIKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "BasicAuth": {
             "type": "basic"
         },
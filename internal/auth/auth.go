@@ -2,6 +2,10 @@ package auth
 
 import (
 	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -15,55 +19,468 @@ import (
 
 // Auth
 // @Summary     Generate Authentication Token
-// @Description Get Authentication Token
+// @Description Get Authentication Token for JID That is Allowed to be Controlled by User
 // @Tags        Root
 // @Produce     json
+// @Param       jid  query  string  false  "JID to Control, Required for Administrator or When User is Allowed to Control More Than One JID"
 // @Success     200
 // @Security    BasicAuth
 // @Router      /auth [get]
 func Auth(c echo.Context) error {
 	var reqAuthBasicInfo typAuth.RequestAuthBasicInfo
-	var resAuthJWTData typAuth.ResponseAuthJWTData
 
 	// Parse Basic Auth Information from Rewrited Body Request
 	// By Basic Auth Middleware
 	_ = json.NewDecoder(c.Request().Body).Decode(&reqAuthBasicInfo)
 
-	// Create JWT Claims
-	var jwtClaims *typAuth.AuthJWTClaims
-	if auth.AuthJWTExpiredHour > 0 {
-		jwtClaims = &typAuth.AuthJWTClaims{
-			typAuth.AuthJWTClaimsPayload{
-				JID: reqAuthBasicInfo.Username,
-			},
-			jwt.StandardClaims{
-				IssuedAt:  time.Now().Unix(),
-				ExpiresAt: time.Now().Add(time.Hour * time.Duration(auth.AuthJWTExpiredHour)).Unix(),
-			},
+	// Resolve JID That Will Be Controlled by The Token
+	jid := strings.TrimSpace(c.QueryParam("jid"))
+
+	// Only Administrator Can Get Token for Any JID
+	// Other User is Limited to Its Allowed JIDs
+	if reqAuthBasicInfo.IsAdmin {
+		if len(jid) == 0 {
+			return router.ResponseBadRequest(c, "Missing Query Value JID")
 		}
 	} else {
-		jwtClaims = &typAuth.AuthJWTClaims{
-			typAuth.AuthJWTClaimsPayload{
-				JID: reqAuthBasicInfo.Username,
-			},
-			jwt.StandardClaims{
-				IssuedAt: time.Now().Unix(),
-			},
+		if len(jid) == 0 {
+			if len(reqAuthBasicInfo.JIDs) != 1 {
+				return router.ResponseBadRequest(c, "Missing Query Value JID")
+			}
+
+			jid = reqAuthBasicInfo.JIDs[0]
+		}
+
+		isAllowed := false
+		for _, allowedJID := range reqAuthBasicInfo.JIDs {
+			if allowedJID == jid {
+				isAllowed = true
+				break
+			}
 		}
-	}
 
-	// Create JWT Token
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims)
+		if !isAllowed {
+			return router.ResponseForbidden(c, "User is Not Allowed to Control JID")
+		}
+	}
 
-	// Generate Encoded JWT Token
-	jwtTokenEncoded, err := jwtToken.SignedString([]byte(auth.AuthJWTSecret))
+	// Every Authentication Start New Token Family
+	family, err := auth.AuthTokenNewID()
 	if err != nil {
 		return router.ResponseInternalError(c, "")
 	}
 
-	// Set Encoded JWT Token as Response Data
-	resAuthJWTData.Token = jwtTokenEncoded
+	resAuthJWTData, err := authIssueToken(family, jid, reqAuthBasicInfo.Username, reqAuthBasicInfo.IsAdmin)
+	if err != nil {
+		return router.ResponseInternalError(c, "")
+	}
 
 	// Return JWT Token in JSON Response
 	return router.ResponseSuccessWithData(c, "Successfully Authenticated", resAuthJWTData)
 }
+
+// authIssueToken Create Short-Lived Access Token and Refresh Token in Token Family
+func authIssueToken(family string, jid string, username string, isAdmin bool) (*typAuth.ResponseAuthJWTData, error) {
+	jti, err := auth.AuthTokenNewID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(time.Minute * time.Duration(auth.AuthJWTExpiredMinute))
+
+	// Create JWT Claims
+	jwtClaims := &typAuth.AuthJWTClaims{
+		Data: typAuth.AuthJWTClaimsPayload{
+			JID: jid,
+		},
+		StandardClaims: jwt.StandardClaims{
+			Id:        jti,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+	}
+
+	// Generate Encoded JWT Token Using Active Signing Key
+	jwtTokenEncoded, err := auth.AuthJWTSign(jwtClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record Access Token to Revocation Store
+	err = auth.AuthTokenSaveAccess(jti, family, jid, username, isAdmin, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := auth.AuthTokenCreateRefresh(family, jid, username, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &typAuth.ResponseAuthJWTData{
+		Token:        jwtTokenEncoded,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(expiresAt.Sub(now).Seconds()),
+	}, nil
+}
+
+// authRevokeUser Revoke Every Token and API Key Issued for User
+func authRevokeUser(username string) error {
+	err := auth.AuthTokenRevokeUser(username)
+	if err != nil {
+		return err
+	}
+
+	return auth.AuthAPIKeyRevokeUser(username)
+}
+
+// ParseToken Parse JWT Token and Make Sure It is Not Revoked
+// Used as JWT Middleware Token Parser
+func ParseToken(authToken string, c echo.Context) (interface{}, error) {
+	// Token Can Be Signed by Any Active Key in The Key Set
+	jwtToken, err := jwt.ParseWithClaims(authToken, &typAuth.AuthJWTClaims{}, auth.AuthJWTKeyFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !jwtToken.Valid {
+		return nil, errors.New("Invalid JWT Token")
+	}
+
+	// JWT Token Without ID is Issued Before Revocation Store Exist
+	jwtClaims := jwtToken.Claims.(*typAuth.AuthJWTClaims)
+	if len(jwtClaims.Id) == 0 {
+		return nil, errors.New("JWT Token is Revoked")
+	}
+
+	isRevoked, err := auth.AuthTokenIsRevoked(jwtClaims.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if isRevoked {
+		return nil, errors.New("JWT Token is Revoked")
+	}
+
+	return jwtToken, nil
+}
+
+// JWKS
+// @Summary     Get JSON Web Key Set
+// @Description Get Public Keys Used to Verify Authentication Token
+// @Tags        Root
+// @Produce     json
+// @Success     200
+// @Router      /.well-known/jwks.json [get]
+func JWKS(c echo.Context) error {
+	// JSON Web Key Set Should Be Returned as Is
+	// So Standard JWT Library Can Consume It
+	return c.JSON(http.StatusOK, auth.AuthJWKS())
+}
+
+// Refresh
+// @Summary     Refresh Authentication Token
+// @Description Exchange Refresh Token With New Access Token and Refresh Token, Every Refresh Token Can Only Be Used Once
+// @Tags        Root
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       refresh_token  formData  string  true  "Refresh Token"
+// @Success     200
+// @Router      /auth/refresh [post]
+func Refresh(c echo.Context) error {
+	var reqAuthRefresh typAuth.RequestAuthRefresh
+	reqAuthRefresh.RefreshToken = strings.TrimSpace(c.FormValue("refresh_token"))
+
+	if len(reqAuthRefresh.RefreshToken) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Refresh Token")
+	}
+
+	refreshToken, err := auth.AuthTokenUseRefresh(reqAuthRefresh.RefreshToken)
+	if err != nil {
+		return router.ResponseUnauthorized(c, err.Error())
+	}
+
+	// Make Sure User is Still Allowed to Control JID
+	if !refreshToken.IsAdmin {
+		user, err := auth.AuthUserGet(refreshToken.Username)
+		if err != nil || user.IsDisabled || !user.IsAllowed(refreshToken.JID) {
+			_ = auth.AuthTokenRevokeFamily(refreshToken.Family)
+			return router.ResponseUnauthorized(c, "User is No Longer Allowed to Control JID")
+		}
+	}
+
+	resAuthJWTData, err := authIssueToken(refreshToken.Family, refreshToken.JID, refreshToken.Username, refreshToken.IsAdmin)
+	if err != nil {
+		return router.ResponseInternalError(c, "")
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Refreshed", resAuthJWTData)
+}
+
+// Revoke
+// @Summary     Revoke Authentication Token
+// @Description Revoke Current Token Session, Session of Given Refresh Token, or Every Token Issued for JID
+// @Tags        Root
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       refresh_token  formData  string  false  "Refresh Token to Revoke Instead of Current Token"
+// @Param       all            formData  bool    false  "Revoke Every Token Issued for JID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /auth/revoke [post]
+func Revoke(c echo.Context) error {
+	var reqAuthRevoke typAuth.RequestAuthRevoke
+	reqAuthRevoke.RefreshToken = strings.TrimSpace(c.FormValue("refresh_token"))
+	reqAuthRevoke.All, _ = strconv.ParseBool(c.FormValue("all"))
+
+	var jid, jti string
+
+	apiKey := auth.APIKeyGet(c)
+	if apiKey != nil {
+		jid = apiKey.JID
+	} else {
+		jwtClaims := c.Get("user").(*jwt.Token).Claims.(*typAuth.AuthJWTClaims)
+
+		jid = jwtClaims.Data.JID
+		jti = jwtClaims.Id
+	}
+
+	switch {
+	case reqAuthRevoke.All:
+		err := auth.AuthTokenRevokeJID(jid)
+		if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+
+		return router.ResponseSuccess(c, "Successfully Revoke Every Token for JID")
+
+	case len(reqAuthRevoke.RefreshToken) > 0:
+		family, refreshJID, err := auth.AuthTokenRefreshFamily(reqAuthRevoke.RefreshToken)
+		if err != nil || refreshJID != jid {
+			return router.ResponseBadRequest(c, "Invalid Refresh Token")
+		}
+
+		err = auth.AuthTokenRevokeFamily(family)
+		if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+
+	default:
+		if len(jti) == 0 {
+			return router.ResponseBadRequest(c, "Missing Form Value Refresh Token")
+		}
+
+		family, err := auth.AuthTokenFamily(jti)
+		if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+
+		err = auth.AuthTokenRevokeFamily(family)
+		if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+	}
+
+	return router.ResponseSuccess(c, "Successfully Revoke Token")
+}
+
+// GetUsers
+// @Summary     Get All Users
+// @Description Get Every User in Credential Store With Allowed JIDs
+// @Tags        Root
+// @Produce     json
+// @Success     200
+// @Security    BasicAuth
+// @Router      /auth/users [get]
+func GetUsers(c echo.Context) error {
+	users, err := auth.AuthUserList()
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Users", users)
+}
+
+// CreateUser
+// @Summary     Create User
+// @Description Create User in Credential Store With Allowed JIDs
+// @Tags        Root
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       username  formData  string  true  "Username"
+// @Param       password  formData  string  true  "Password, Minimum 8 Characters"
+// @Param       jids      formData  string  true  "Comma Separated JIDs That Allowed to be Controlled"
+// @Success     200
+// @Security    BasicAuth
+// @Router      /auth/users [post]
+func CreateUser(c echo.Context) error {
+	var reqAuthUser typAuth.RequestAuthUser
+	reqAuthUser.Username = strings.TrimSpace(c.FormValue("username"))
+	reqAuthUser.Password = c.FormValue("password")
+	reqAuthUser.JIDs = strings.TrimSpace(c.FormValue("jids"))
+
+	if len(reqAuthUser.Username) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Username")
+	}
+
+	if len(reqAuthUser.Password) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Password")
+	}
+
+	if len(reqAuthUser.JIDs) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value JIDs")
+	}
+
+	user, err := auth.AuthUserCreate(reqAuthUser.Username, reqAuthUser.Password, strings.Split(reqAuthUser.JIDs, ","))
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Create User", user)
+}
+
+// SetUserJIDs
+// @Summary     Set User Allowed JIDs
+// @Description Replace JIDs That Allowed to be Controlled by User
+// @Tags        Root
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       username  path      string  true  "Username"
+// @Param       jids      formData  string  true  "Comma Separated JIDs That Allowed to be Controlled"
+// @Success     200
+// @Security    BasicAuth
+// @Router      /auth/users/{username}/jids [post]
+func SetUserJIDs(c echo.Context) error {
+	var reqAuthUser typAuth.RequestAuthUser
+	reqAuthUser.Username = c.Param("username")
+	reqAuthUser.JIDs = strings.TrimSpace(c.FormValue("jids"))
+
+	if len(reqAuthUser.JIDs) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value JIDs")
+	}
+
+	user, err := auth.AuthUserSetJIDs(reqAuthUser.Username, strings.Split(reqAuthUser.JIDs, ","))
+	if errors.Is(err, auth.ErrAuthUserNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	// Token and API Key Issued for User Should Not Outlive Its Access
+	err = authRevokeUser(reqAuthUser.Username)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Set User JIDs", user)
+}
+
+// DisableUser
+// @Summary     Disable User
+// @Description Disable User So It Can Not Generate Authentication Token
+// @Tags        Root
+// @Produce     json
+// @Param       username  path  string  true  "Username"
+// @Success     200
+// @Security    BasicAuth
+// @Router      /auth/users/{username}/disable [post]
+func DisableUser(c echo.Context) error {
+	user, err := auth.AuthUserSetDisabled(c.Param("username"), true)
+	if errors.Is(err, auth.ErrAuthUserNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	// Token and API Key Issued for User Should Not Outlive Its Access
+	err = authRevokeUser(c.Param("username"))
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Disable User", user)
+}
+
+// EnableUser
+// @Summary     Enable User
+// @Description Enable Previously Disabled User
+// @Tags        Root
+// @Produce     json
+// @Param       username  path  string  true  "Username"
+// @Success     200
+// @Security    BasicAuth
+// @Router      /auth/users/{username}/enable [post]
+func EnableUser(c echo.Context) error {
+	user, err := auth.AuthUserSetDisabled(c.Param("username"), false)
+	if errors.Is(err, auth.ErrAuthUserNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Enable User", user)
+}
+
+// RotateUser
+// @Summary     Rotate User Password
+// @Description Replace User Password, Random Password Will Be Generated if Empty
+// @Tags        Root
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       username  path      string  true   "Username"
+// @Param       password  formData  string  false  "New Password, Minimum 8 Characters"
+// @Success     200
+// @Security    BasicAuth
+// @Router      /auth/users/{username}/rotate [post]
+func RotateUser(c echo.Context) error {
+	var reqAuthUserRotate typAuth.RequestAuthUserRotate
+	var resAuthUserRotate typAuth.ResponseAuthUserRotate
+
+	reqAuthUserRotate.Password = c.FormValue("password")
+
+	password, err := auth.AuthUserRotatePassword(c.Param("username"), reqAuthUserRotate.Password)
+	if errors.Is(err, auth.ErrAuthUserNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	// Token and API Key Issued for User Should Not Outlive Its Access
+	err = authRevokeUser(c.Param("username"))
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	resAuthUserRotate.Username = c.Param("username")
+	resAuthUserRotate.Password = password
+
+	return router.ResponseSuccessWithData(c, "Successfully Rotate User Password", resAuthUserRotate)
+}
+
+// DeleteUser
+// @Summary     Delete User
+// @Description Delete User From Credential Store
+// @Tags        Root
+// @Produce     json
+// @Param       username  path  string  true  "Username"
+// @Success     200
+// @Security    BasicAuth
+// @Router      /auth/users/{username} [delete]
+func DeleteUser(c echo.Context) error {
+	err := auth.AuthUserDelete(c.Param("username"))
+	if errors.Is(err, auth.ErrAuthUserNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	// Token and API Key Issued for User Should Not Outlive Its Access
+	err = authRevokeUser(c.Param("username"))
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Delete User")
+}
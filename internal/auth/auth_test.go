@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	typAuth "github.com/dimaskiddo/go-whatsapp-multidevice-rest/internal/auth/types"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/auth"
+)
+
+func TestParseTokenRevocation(t *testing.T) {
+	auth.AuthJWTSecret = "secret"
+
+	family, err := auth.AuthTokenNewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resAuthJWTData, err := authIssueToken(family, "6281", "alice", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = ParseToken(resAuthJWTData.Token, nil); err != nil {
+		t.Fatalf("ParseToken = %v, want valid token", err)
+	}
+
+	err = authRevokeUser("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = ParseToken(resAuthJWTData.Token, nil); err == nil {
+		t.Error("ParseToken accepts token of revoked user")
+	}
+}
+
+func TestParseTokenNotRecorded(t *testing.T) {
+	auth.AuthJWTSecret = "secret"
+
+	tests := map[string]string{
+		"without jti": "",
+		"unknown jti": "unknown",
+	}
+
+	for name, jti := range tests {
+		jwtToken, err := auth.AuthJWTSign(&typAuth.AuthJWTClaims{
+			Data: typAuth.AuthJWTClaimsPayload{
+				JID: "6281",
+			},
+			StandardClaims: jwt.StandardClaims{
+				Id:        jti,
+				ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = ParseToken(jwtToken, nil); err == nil {
+			t.Errorf("%s: ParseToken accepts token that is not recorded", name)
+		}
+	}
+}
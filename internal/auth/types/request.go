@@ -2,4 +2,25 @@ package types
 
 type RequestAuthBasicInfo struct {
 	Username string
+	IsAdmin  bool `json:"is_admin"`
+	JIDs     []string
+}
+
+type RequestAuthUser struct {
+	Username string
+	Password string
+	JIDs     string
+}
+
+type RequestAuthUserRotate struct {
+	Password string
+}
+
+type RequestAuthRefresh struct {
+	RefreshToken string
+}
+
+type RequestAuthRevoke struct {
+	RefreshToken string
+	All          bool
 }
@@ -1,5 +1,12 @@
 package types
 
 type ResponseAuthJWTData struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+type ResponseAuthUserRotate struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
 }
@@ -11,8 +11,6 @@ import (
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/router"
 
 	ctlAuth "github.com/dimaskiddo/go-whatsapp-multidevice-rest/internal/auth"
-	typAuth "github.com/dimaskiddo/go-whatsapp-multidevice-rest/internal/auth/types"
-
 	ctlIndex "github.com/dimaskiddo/go-whatsapp-multidevice-rest/internal/index"
 	ctlWhatsApp "github.com/dimaskiddo/go-whatsapp-multidevice-rest/internal/whatsapp"
 )
@@ -33,24 +31,143 @@ func Routes(e *echo.Echo) {
 	// Route for Auth
 	// ---------------------------------------------
 	e.GET(router.BaseURL+"/auth", ctlAuth.Auth, auth.BasicAuth())
+	e.POST(router.BaseURL+"/auth/refresh", ctlAuth.Refresh)
+
+	// JSON Web Key Set is Also Served in Root Path as Defined in Well-Known URI
+	e.GET("/.well-known/jwks.json", ctlAuth.JWKS)
+	e.GET(router.BaseURL+"/.well-known/jwks.json", ctlAuth.JWKS)
+
+	e.GET(router.BaseURL+"/auth/users", ctlAuth.GetUsers, auth.BasicAuthAdmin())
+	e.POST(router.BaseURL+"/auth/users", ctlAuth.CreateUser, auth.BasicAuthAdmin())
+	e.DELETE(router.BaseURL+"/auth/users/:username", ctlAuth.DeleteUser, auth.BasicAuthAdmin())
+	e.POST(router.BaseURL+"/auth/users/:username/jids", ctlAuth.SetUserJIDs, auth.BasicAuthAdmin())
+	e.POST(router.BaseURL+"/auth/users/:username/disable", ctlAuth.DisableUser, auth.BasicAuthAdmin())
+	e.POST(router.BaseURL+"/auth/users/:username/enable", ctlAuth.EnableUser, auth.BasicAuthAdmin())
+	e.POST(router.BaseURL+"/auth/users/:username/rotate", ctlAuth.RotateUser, auth.BasicAuthAdmin())
 
 	// Route for WhatsApp
 	// ---------------------------------------------
+	// JWT Token is Verified Against Any Active Key in The Key Set
+	// And Checked Against Revocation Store
 	authJWTConfig := middleware.JWTConfig{
-		Claims:     &typAuth.AuthJWTClaims{},
-		SigningKey: []byte(auth.AuthJWTSecret),
+		ParseTokenFunc: ctlAuth.ParseToken,
+		Skipper: func(c echo.Context) bool {
+			// Request Already Authorized Using API Key
+			return auth.APIKeyGet(c) != nil
+		},
+	}
+
+	// Browser WebSocket Can Not Set Authorization Header
+	// So WebSocket Endpoint Also Accept JWT Token From Query Parameter
+	// Query Parameter is Not Accepted Anywhere Else Since It Can Leak Through Access Log
+	authJWTWebSocketConfig := authJWTConfig
+	authJWTWebSocketConfig.TokenLookup = "header:" + echo.HeaderAuthorization + ",query:token"
+
+	// API Key is Accepted as Alternative of JWT Token
+	authJWT := []echo.MiddlewareFunc{auth.APIKeyAuth(), middleware.JWTWithConfig(authJWTConfig)}
+	authJWTWebSocket := []echo.MiddlewareFunc{auth.APIKeyAuth(), middleware.JWTWithConfig(authJWTWebSocketConfig)}
+
+	// API Key is Limited to Its Scopes, JWT Token Has Full Access to Its JID
+	authJWTScope := func(authJWTBase []echo.MiddlewareFunc, scope string) []echo.MiddlewareFunc {
+		return append(append([]echo.MiddlewareFunc{}, authJWTBase...), auth.RequireScope(scope))
 	}
 
-	e.POST(router.BaseURL+"/login", ctlWhatsApp.Login, middleware.JWTWithConfig(authJWTConfig))
-	e.POST(router.BaseURL+"/login/pair", ctlWhatsApp.LoginPair, middleware.JWTWithConfig(authJWTConfig))
-	e.POST(router.BaseURL+"/logout", ctlWhatsApp.Logout, middleware.JWTWithConfig(authJWTConfig))
+	authSend := authJWTScope(authJWT, auth.AuthScopeSend)
+	authGroupRead := authJWTScope(authJWT, auth.AuthScopeGroupRead)
+	authGroupWrite := authJWTScope(authJWT, auth.AuthScopeGroupWrite)
+	authSessionAdmin := authJWTScope(authJWT, auth.AuthScopeSessionAdmin)
+	authEventsRead := authJWTScope(authJWT, auth.AuthScopeEventsRead)
+	authEventsReadWebSocket := authJWTScope(authJWTWebSocket, auth.AuthScopeEventsRead)
+
+	e.POST(router.BaseURL+"/login", ctlWhatsApp.Login, authSessionAdmin...)
+	e.POST(router.BaseURL+"/login/pair", ctlWhatsApp.LoginPair, authSessionAdmin...)
+	e.GET(router.BaseURL+"/login/stream", ctlWhatsApp.LoginStream, authSessionAdmin...)
+	e.POST(router.BaseURL+"/logout", ctlWhatsApp.Logout, authSessionAdmin...)
+
+	e.GET(router.BaseURL+"/session", ctlWhatsApp.GetSession, authSessionAdmin...)
+	e.GET(router.BaseURL+"/sessions", ctlWhatsApp.GetSessions, auth.BasicAuthAdmin())
+
+	e.POST(router.BaseURL+"/auth/revoke", ctlAuth.Revoke, authSessionAdmin...)
+
+	e.GET(router.BaseURL+"/apikey", ctlWhatsApp.GetAPIKeys, authSessionAdmin...)
+	e.POST(router.BaseURL+"/apikey", ctlWhatsApp.CreateAPIKey, authSessionAdmin...)
+	e.DELETE(router.BaseURL+"/apikey/:id", ctlWhatsApp.RevokeAPIKey, authSessionAdmin...)
+
+	e.GET(router.BaseURL+"/registered", ctlWhatsApp.Registered, authSend...)
+	e.POST(router.BaseURL+"/registered/batch", ctlWhatsApp.RegisteredBatch, authSend...)
+
+	e.GET(router.BaseURL+"/group", ctlWhatsApp.GetGroup, authGroupRead...)
+	e.POST(router.BaseURL+"/group", ctlWhatsApp.CreateGroup, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/join", ctlWhatsApp.JoinGroup, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/join/invite", ctlWhatsApp.JoinGroupInvite, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/leave", ctlWhatsApp.LeaveGroup, authGroupWrite...)
+	e.GET(router.BaseURL+"/group/invite/preview", ctlWhatsApp.PreviewGroupInvite, authGroupRead...)
+	e.GET(router.BaseURL+"/group/:gid/invite", ctlWhatsApp.GetGroupInvite, authGroupRead...)
+	e.GET(router.BaseURL+"/group/:gid", ctlWhatsApp.GetGroupDetail, authGroupRead...)
+	e.POST(router.BaseURL+"/group/:gid/invite/reset", ctlWhatsApp.ResetGroupInvite, authGroupWrite...)
+	e.GET(router.BaseURL+"/group/:gid/history", ctlWhatsApp.GetGroupHistory, authGroupRead...)
+	e.GET(router.BaseURL+"/group/:gid/requests", ctlWhatsApp.GetGroupRequests, authGroupRead...)
+	e.POST(router.BaseURL+"/group/:gid/requests/approve", ctlWhatsApp.ApproveGroupRequests, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/requests/reject", ctlWhatsApp.RejectGroupRequests, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/participants/add", ctlWhatsApp.AddGroupParticipants, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/participants/remove", ctlWhatsApp.RemoveGroupParticipants, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/participants/promote", ctlWhatsApp.PromoteGroupParticipants, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/participants/demote", ctlWhatsApp.DemoteGroupParticipants, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/subject", ctlWhatsApp.SetGroupSubject, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/description", ctlWhatsApp.SetGroupDescription, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/photo", ctlWhatsApp.SetGroupPhoto, authGroupWrite...)
+	e.DELETE(router.BaseURL+"/group/:gid/photo", ctlWhatsApp.DeleteGroupPhoto, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/announce", ctlWhatsApp.SetGroupAnnounce, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/locked", ctlWhatsApp.SetGroupLocked, authGroupWrite...)
+	e.POST(router.BaseURL+"/group/:gid/disappearing", ctlWhatsApp.SetGroupDisappearing, authGroupWrite...)
+
+	e.POST(router.BaseURL+"/community", ctlWhatsApp.CreateCommunity, authGroupWrite...)
+	e.GET(router.BaseURL+"/community/:cid/subgroups", ctlWhatsApp.GetCommunitySubGroups, authGroupRead...)
+	e.POST(router.BaseURL+"/community/:cid/link", ctlWhatsApp.LinkCommunityGroup, authGroupWrite...)
+	e.POST(router.BaseURL+"/community/:cid/unlink", ctlWhatsApp.UnlinkCommunityGroup, authGroupWrite...)
+	e.POST(router.BaseURL+"/community/:cid/announce", ctlWhatsApp.SendCommunityAnnouncement, authSend...)
+
+	e.POST(router.BaseURL+"/send/text", ctlWhatsApp.SendText, authSend...)
+	e.POST(router.BaseURL+"/send/location", ctlWhatsApp.SendLocation, authSend...)
+	e.POST(router.BaseURL+"/send/contact", ctlWhatsApp.SendContact, authSend...)
+	e.POST(router.BaseURL+"/send/link", ctlWhatsApp.SendLink, authSend...)
+	e.POST(router.BaseURL+"/send/poll", ctlWhatsApp.SendPoll, authSend...)
+	e.POST(router.BaseURL+"/send/document", ctlWhatsApp.SendDocument, authSend...)
+	e.POST(router.BaseURL+"/send/image", ctlWhatsApp.SendImage, authSend...)
+	e.POST(router.BaseURL+"/send/audio", ctlWhatsApp.SendAudio, authSend...)
+	e.POST(router.BaseURL+"/send/video", ctlWhatsApp.SendVideo, authSend...)
+	e.POST(router.BaseURL+"/send/sticker", ctlWhatsApp.SendSticker, authSend...)
+	e.POST(router.BaseURL+"/send/bulk", ctlWhatsApp.SendBulk, authSend...)
+	e.GET(router.BaseURL+"/send/bulk/:id", ctlWhatsApp.GetBulk, authSend...)
+	e.GET(router.BaseURL+"/send/bulk/:id/recipients", ctlWhatsApp.GetBulkRecipients, authSend...)
+	e.GET(router.BaseURL+"/send/bulk/:id/csv", ctlWhatsApp.GetBulkCSV, authSend...)
+
+	e.POST(router.BaseURL+"/message/edit", ctlWhatsApp.MessageEdit, authSend...)
+	e.POST(router.BaseURL+"/message/react", ctlWhatsApp.MessageReact, authSend...)
+	e.POST(router.BaseURL+"/message/delete", ctlWhatsApp.MessageDelete, authSend...)
+
+	e.GET(router.BaseURL+"/messages", ctlWhatsApp.GetMessages, authEventsRead...)
+	e.GET(router.BaseURL+"/message/:msgid/status", ctlWhatsApp.GetMessageStatus, authSend...)
+
+	e.GET(router.BaseURL+"/queue", ctlWhatsApp.GetQueueJobs, authSend...)
+	e.GET(router.BaseURL+"/queue/:id", ctlWhatsApp.GetQueueJob, authSend...)
+
+	e.GET(router.BaseURL+"/schedule", ctlWhatsApp.GetSchedules, authSend...)
+	e.POST(router.BaseURL+"/schedule", ctlWhatsApp.CreateSchedule, authSend...)
+	e.GET(router.BaseURL+"/schedule/:id", ctlWhatsApp.GetSchedule, authSend...)
+	e.DELETE(router.BaseURL+"/schedule/:id", ctlWhatsApp.DeleteSchedule, authSend...)
+	e.POST(router.BaseURL+"/schedule/:id/pause", ctlWhatsApp.PauseSchedule, authSend...)
+	e.POST(router.BaseURL+"/schedule/:id/resume", ctlWhatsApp.ResumeSchedule, authSend...)
+	e.GET(router.BaseURL+"/schedule/:id/history", ctlWhatsApp.GetScheduleHistory, authSend...)
 
-	e.GET(router.BaseURL+"/registered", ctlWhatsApp.Registered, middleware.JWTWithConfig(authJWTConfig))
+	e.GET(router.BaseURL+"/poll/:msgid/results", ctlWhatsApp.GetPollResults, authEventsRead...)
 
-	e.GET(router.BaseURL+"/group", ctlWhatsApp.GetGroup, middleware.JWTWithConfig(authJWTConfig))
-	e.POST(router.BaseURL+"/group/join", ctlWhatsApp.JoinGroup, middleware.JWTWithConfig(authJWTConfig))
-	e.POST(router.BaseURL+"/group/leave", ctlWhatsApp.LeaveGroup, middleware.JWTWithConfig(authJWTConfig))
+	e.GET(router.BaseURL+"/webhook", ctlWhatsApp.GetWebhook, authSessionAdmin...)
+	e.POST(router.BaseURL+"/webhook", ctlWhatsApp.SetWebhook, authSessionAdmin...)
+	e.DELETE(router.BaseURL+"/webhook", ctlWhatsApp.DeleteWebhook, authSessionAdmin...)
+	e.GET(router.BaseURL+"/webhook/deadletter", ctlWhatsApp.GetWebhookDeadLetters, authSessionAdmin...)
+	e.DELETE(router.BaseURL+"/webhook/deadletter", ctlWhatsApp.PurgeWebhookDeadLetters, authSessionAdmin...)
 
-	e.POST(router.BaseURL+"/send/text", ctlWhatsApp.SendText, middleware.JWTWithConfig(authJWTConfig))
-	e.POST(router.BaseURL+"/send/location", ctlWhatsApp.SendLocation, middleware.JWTWithConfig(authJWTConfig))
+	e.GET(router.BaseURL+"/events/stream", ctlWhatsApp.EventStream, authEventsRead...)
+	e.GET(router.BaseURL+"/events/ws", ctlWhatsApp.EventWebSocket, authEventsReadWebSocket...)
 }
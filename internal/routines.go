@@ -3,6 +3,7 @@ package internal
 import (
 	"github.com/robfig/cron/v3"
 
+	pkgAuth "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/auth"
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
 	pkgWhatsApp "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/whatsapp"
 )
@@ -11,31 +12,64 @@ func Routines(cron *cron.Cron) {
 	log.Print(nil).Info("Running Routine Tasks")
 
 	cron.AddFunc("0 * * * * *", func() {
-		// If WhatsAppClient Connection is more than 0
-		if len(pkgWhatsApp.WhatsAppClient) > 0 {
-			// Check Every Authenticated MSISDN
-			for jid, client := range pkgWhatsApp.WhatsAppClient {
-				// Get Real JID from Datastore
-				realJID := client.Store.ID.User
-
-				// Mask JID for Logging Information
-				maskJID := realJID[0:len(realJID)-4] + "xxxx"
-
-				// Print Log Show Information of Device Checking
-				log.Print(nil).Info("Checking WhatsApp Client for " + maskJID)
-
-				// Check WhatsAppClient Registered JID with Authenticated MSISDN
-				if jid != realJID {
-					// Print Log Show Information to Force Log-out Device
-					log.Print(nil).Info("Logging out WhatsApp Client for " + maskJID + " Due to Missmatch Authentication")
-
-					// Logout WhatsAppClient Device
-					_ = pkgWhatsApp.WhatsAppLogout(jid)
-					delete(pkgWhatsApp.WhatsAppClient, jid)
-				}
+		// Check Every Authenticated MSISDN
+		// Using Snapshot of Session Registry JIDs
+		for _, jid := range pkgWhatsApp.WhatsAppSession.List() {
+			client := pkgWhatsApp.WhatsAppSession.Get(jid)
+
+			// Skip Removed or Not Yet Paired WhatsApp Client
+			if client == nil || client.Store.ID == nil {
+				continue
+			}
+
+			// Get Real JID from Datastore
+			realJID := client.Store.ID.User
+
+			// Mask JID for Logging Information
+			maskJID := realJID[0:len(realJID)-4] + "xxxx"
+
+			// Print Log Show Information of Device Checking
+			log.Print(nil).Info("Checking WhatsApp Client for " + maskJID)
+
+			// Check WhatsAppClient Registered JID with Authenticated MSISDN
+			if jid != realJID {
+				// Print Log Show Information to Force Log-out Device
+				log.Print(nil).Info("Logging out WhatsApp Client for " + maskJID + " Due to Missmatch Authentication")
+
+				// Logout WhatsAppClient Device
+				_ = pkgWhatsApp.WhatsAppLogout(jid)
+				pkgWhatsApp.WhatsAppSession.Remove(jid, client)
 			}
 		}
 	})
 
+	cron.AddFunc("30 * * * * *", func() {
+		// Reload JWT Signing Keys So Key Rotation Does Not Need Restart
+		err := pkgAuth.AuthJWTKeysLoad()
+		if err != nil {
+			log.Print(nil).Error("Failed to Reload JWT Signing Keys: " + err.Error())
+		}
+	})
+
+	cron.AddFunc("0 0 * * * *", func() {
+		// Remove Expired Token From Revocation Store
+		err := pkgAuth.AuthTokenPurgeExpired()
+		if err != nil {
+			log.Print(nil).Error("Failed to Purge Expired Auth Token: " + err.Error())
+		}
+
+		// Remove Expired Registered Check Result From Cache
+		pkgWhatsApp.WhatsAppRegisteredPurge()
+
+		// Remove Finished Outbound Queue Jobs Older Than Retention
+		err = pkgWhatsApp.WhatsAppQueuePurge()
+		if err != nil {
+			log.Print(nil).Error("Failed to Purge WhatsApp Queue Job: " + err.Error())
+		}
+	})
+
+	// Register Persisted Message Schedules
+	pkgWhatsApp.WhatsAppScheduleStart(cron)
+
 	cron.Start()
 }
@@ -1,13 +1,36 @@
 package internal
 
 import (
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+	pkgStream "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/stream"
+	pkgWebhook "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/webhook"
 	pkgWhatsApp "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/whatsapp"
 )
 
 func Startup() {
 	log.Print(nil).Info("Running Startup Tasks")
 
+	// Connect Datastore and Apply Pending Migrations
+	err := datastore.Connect()
+	if err != nil {
+		log.Print(nil).Fatal(err.Error())
+	}
+
+	err = pkgWhatsApp.WhatsAppDatastoreConnect()
+	if err != nil {
+		log.Print(nil).Fatal(err.Error())
+	}
+
+	// Deliver Every WhatsApp Event to Configured Webhook
+	pkgWhatsApp.WhatsAppAddEventListener(pkgWebhook.WebhookDispatch)
+
+	// Resume Pending Webhook Deliveries
+	pkgWebhook.WebhookStart()
+
+	// Publish Every WhatsApp Event to Live Event Stream
+	pkgWhatsApp.WhatsAppAddEventListener(pkgStream.StreamPublish)
+
 	// Load All WhatsApp Client Devices from Datastore
 	devices, err := pkgWhatsApp.WhatsAppDatastore.GetAllDevices()
 	if err != nil {
@@ -34,4 +57,10 @@ func Startup() {
 			log.Print(nil).Error(err.Error())
 		}
 	}
+
+	// Resume Pending Outbound Queue Jobs
+	pkgWhatsApp.WhatsAppQueueStart()
+
+	// Resume Running Bulk Messages
+	pkgWhatsApp.WhatsAppBulkStart()
 }
@@ -18,8 +18,9 @@ type RequestSendLocation struct {
 
 type RequestSendContact struct {
 	RJID  string
-	Name  string
-	Phone string
+	Name  []string
+	Phone []string
+	VCard []string
 }
 
 type RequestSendLink struct {
@@ -35,9 +36,26 @@ type RequestSendPoll struct {
 	MultiAnswer bool
 }
 
+type RequestSendBulk struct {
+	Type       string
+	Recipients string
+}
+
+type RequestBulkRecipients struct {
+	State string
+	After int64
+	Limit int
+}
+
+type RequestRegisteredBatch struct {
+	MSISDN      []string
+	CountryCode string
+}
+
 type RequestMessage struct {
 	RJID    string
 	MSGID   string
+	Sender  string
 	Message string
 	Emoji   string
 }
@@ -49,3 +67,89 @@ type RequestGroupJoin struct {
 type RequestGroupLeave struct {
 	GID string
 }
+
+type RequestGroupList struct {
+	Query   string
+	IsAdmin bool
+	Parent  string
+	After   string
+	Limit   int
+}
+
+type RequestGroupHistory struct {
+	Action string
+	Before int64
+	Limit  int
+}
+
+type RequestGroupInvite struct {
+	Link       string
+	GID        string
+	Inviter    string
+	Code       string
+	Expiration int64
+}
+
+type RequestGroupCreate struct {
+	Name         string
+	Parent       string
+	Participants []string
+}
+
+type RequestCommunityLink struct {
+	CID string
+	GID string
+}
+
+type RequestGroupParticipants struct {
+	GID          string
+	Participants []string
+}
+
+type RequestGroupInfo struct {
+	GID         string
+	Subject     string
+	Description string
+}
+
+type RequestGroupSetting struct {
+	GID     string
+	Enabled bool
+	Timer   string
+}
+
+type RequestWebhook struct {
+	URL    string
+	Secret string
+}
+
+type RequestMessageHistory struct {
+	Chat   string
+	Type   string
+	Before int64
+	Limit  int
+}
+
+type RequestQueueJobs struct {
+	State  string
+	Before int64
+	Limit  int
+}
+
+type RequestSchedule struct {
+	Name     string
+	Type     string
+	RunAt    string
+	Cron     string
+	Timezone string
+}
+
+type RequestScheduleHistory struct {
+	Before int64
+	Limit  int
+}
+
+type RequestAPIKey struct {
+	Name   string
+	Scopes string
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	pkgAuth "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/auth"
+	pkgWhatsApp "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/whatsapp"
+)
+
 type ResponseLogin struct {
 	QRCode  string `json:"qrcode"`
 	Timeout int    `json:"timeout"`
@@ -11,5 +16,27 @@ type ResponsePairing struct {
 }
 
 type ResponseSendMessage struct {
-	MsgID string `json:"msgid"`
+	MsgID string `json:"msgid,omitempty"`
+	JobID int64  `json:"job_id,omitempty"`
+}
+
+type ResponseEventStream struct {
+	ID    uint64      `json:"id"`
+	Event interface{} `json:"event"`
+}
+
+type ResponseLoginStream struct {
+	Event string `json:"event"`
+	Error string `json:"error,omitempty"`
+}
+
+type ResponseAPIKey struct {
+	pkgAuth.AuthAPIKey
+	Key string `json:"key"`
+}
+
+type ResponseRegisteredBatch struct {
+	Input string `json:"input"`
+	pkgWhatsApp.RegisteredInfo
+	Error string `json:"error,omitempty"`
 }
@@ -2,15 +2,28 @@ package whatsapp
 
 import (
 	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"mime/multipart"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/forPelevin/gomoji"
 	"github.com/golang-jwt/jwt"
+	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
+	"github.com/rivo/uniseg"
+	"go.mau.fi/whatsmeow"
 
+	pkgAuth "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/auth"
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/router"
+	pkgStream "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/stream"
+	pkgWebhook "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/webhook"
 	pkgWhatsApp "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/whatsapp"
 
 	typAuth "github.com/dimaskiddo/go-whatsapp-multidevice-rest/internal/auth/types"
@@ -18,12 +31,29 @@ import (
 )
 
 func jwtPayload(c echo.Context) typAuth.AuthJWTClaimsPayload {
+	// Request Authorized Using API Key Use API Key JID
+	if apiKey := pkgAuth.APIKeyGet(c); apiKey != nil {
+		return typAuth.AuthJWTClaimsPayload{
+			JID: apiKey.JID,
+		}
+	}
+
 	jwtToken := c.Get("user").(*jwt.Token)
 	jwtClaims := jwtToken.Claims.(*typAuth.AuthJWTClaims)
 
 	return jwtClaims.Data
 }
 
+// authScope Check if Request is Allowed to Access Given Scope
+// JWT Token Has Full Access to Its JID, API Key is Limited to Its Scopes
+func authScope(c echo.Context, scope string) bool {
+	if apiKey := pkgAuth.APIKeyGet(c); apiKey != nil {
+		return apiKey.HasScope(scope)
+	}
+
+	return true
+}
+
 func convertFileToBytes(file multipart.File) ([]byte, error) {
 	// Create Empty Buffer
 	buffer := bytes.NewBuffer(nil)
@@ -37,6 +67,86 @@ func convertFileToBytes(file multipart.File) ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// formValueList Get Form Values That Can be Repeated or Comma Separated
+func formValueList(c echo.Context, name string) ([]string, error) {
+	formParams, err := c.FormParams()
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for _, formValue := range formParams[name] {
+		for _, value := range strings.Split(formValue, ",") {
+			value = strings.TrimSpace(value)
+			if len(value) > 0 {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// composeSendMessage Compose Any Supported Message Type From Request Form Values
+// And Make Sure Destination is Filled
+func composeSendMessage(c echo.Context, messageType string) (*pkgWhatsApp.QueueMessage, error) {
+	msg, err := composeMessage(c, messageType)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.RJID) == 0 {
+		return nil, errors.New("Missing Form Value MSISDN")
+	}
+
+	return msg, nil
+}
+
+// composeMessage Compose Any Supported Message Type From Request Form Values
+// Destination is Not Validated Since Bulk Message and Community Announcement Set Their Own Destination
+func composeMessage(c echo.Context, messageType string) (*pkgWhatsApp.QueueMessage, error) {
+	switch messageType {
+	case pkgWhatsApp.QueueTypeText:
+		return composeSendText(c)
+
+	case pkgWhatsApp.QueueTypeLocation:
+		return composeSendLocation(c)
+
+	case pkgWhatsApp.QueueTypeLink:
+		return composeSendLink(c)
+
+	case pkgWhatsApp.QueueTypePoll:
+		return composeSendPoll(c)
+
+	case pkgWhatsApp.QueueTypeContact:
+		return composeSendContact(c)
+
+	case pkgWhatsApp.QueueTypeDocument, pkgWhatsApp.QueueTypeImage, pkgWhatsApp.QueueTypeAudio,
+		pkgWhatsApp.QueueTypeVideo, pkgWhatsApp.QueueTypeSticker:
+		return composeSendMedia(c, messageType)
+	}
+
+	return nil, errors.New("Invalid Message Type " + messageType)
+}
+
+// sendMessage Send Composed Message and Response Sent Message ID
+// Or Queue Job ID When Outbound Queue is Enabled
+func sendMessage(c echo.Context, jid string, messageType string, msg *pkgWhatsApp.QueueMessage) error {
+	var err error
+
+	var resSendMessage typWhatsApp.ResponseSendMessage
+	resSendMessage.MsgID, resSendMessage.JobID, err = pkgWhatsApp.WhatsAppQueueSend(c.Request().Context(), jid, msg)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	if resSendMessage.JobID > 0 {
+		return router.ResponseSuccessWithData(c, "Successfully Queue "+messageType+" Message", resSendMessage)
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Send "+messageType+" Message", resSendMessage)
+}
+
 // Login
 // @Summary     Generate QR Code for WhatsApp Multi-Device Login
 // @Description Get QR Code for WhatsApp Multi-Device Login
@@ -47,6 +157,7 @@ func convertFileToBytes(file multipart.File) ([]byte, error) {
 // @Param       output    formData  string  false  "Change Output Format in HTML or JSON"  Enums(html, json)  default(html)
 // @Success     200
 // @Security    BearerAuth
+// @Security    APIKeyAuth
 // @Router      /login [post]
 func Login(c echo.Context) error {
 	var err error
@@ -109,6 +220,7 @@ func Login(c echo.Context) error {
 // @Produce     json
 // @Success     200
 // @Security    BearerAuth
+// @Security    APIKeyAuth
 // @Router      /login/pair [post]
 func LoginPair(c echo.Context) error {
 	var err error
@@ -136,6 +248,87 @@ func LoginPair(c echo.Context) error {
 	return router.ResponseSuccessWithData(c, "Successfully Generated Pairing Code", resPairing)
 }
 
+// LoginStream
+// @Summary     Stream Rotating QR Code for WhatsApp Multi-Device Login
+// @Description Stream Every Rotating QR Code Using Server-Sent Events Until Success, Timeout or Error Event
+// @Tags        WhatsApp Authentication
+// @Produce     text/event-stream
+// @Param       output    query     string  false  "Change QR Code Output Format"  Enums(png, svg, ascii)  default(png)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /login/stream [get]
+func LoginStream(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	var reqLogin typWhatsApp.RequestLogin
+	reqLogin.Output = strings.ToLower(strings.TrimSpace(c.QueryParam("output")))
+
+	if len(reqLogin.Output) == 0 {
+		reqLogin.Output = "png"
+	}
+
+	if reqLogin.Output != "png" && reqLogin.Output != "svg" && reqLogin.Output != "ascii" {
+		return router.ResponseBadRequest(c, "Query Value Output Should be PNG, SVG or ASCII")
+	}
+
+	// Initialize WhatsApp Client
+	pkgWhatsApp.WhatsAppInitClient(nil, jid)
+
+	// QR Channel is Bound to Request Context
+	// So QR Generator is Stopped When Client Went Away
+	qrChan, err := pkgWhatsApp.WhatsAppLoginStream(c.Request().Context(), jid)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	serverSentEventsStart(c)
+
+	// If Return is Not QR Channel But Reconnected
+	// Then Send Reconnected Event
+	if qrChan == nil {
+		return serverSentEventsWrite(c, "", "reconnected", typWhatsApp.ResponseLoginStream{
+			Event: "reconnected",
+		})
+	}
+
+	for evt := range qrChan {
+		if evt.Event == whatsmeow.QRChannelEventCode {
+			// QR Code That Can Not Be Rendered Ends Stream With Final Error Event
+			qrCodeImage, err := pkgWhatsApp.WhatsAppRenderQR(evt.Code, reqLogin.Output)
+			if err != nil {
+				return serverSentEventsWrite(c, "", "err-render", typWhatsApp.ResponseLoginStream{
+					Event: "err-render",
+					Error: err.Error(),
+				})
+			}
+
+			err = serverSentEventsWrite(c, "", evt.Event, typWhatsApp.ResponseLogin{
+				QRCode:  qrCodeImage,
+				Timeout: int(evt.Timeout.Seconds()),
+			})
+			if err != nil {
+				return nil
+			}
+
+			continue
+		}
+
+		// Every Other QR Channel Event is Final Event
+		resLoginStream := typWhatsApp.ResponseLoginStream{
+			Event: evt.Event,
+		}
+
+		if evt.Error != nil {
+			resLoginStream.Error = evt.Error.Error()
+		}
+
+		return serverSentEventsWrite(c, "", evt.Event, resLoginStream)
+	}
+
+	return nil
+}
+
 // Logout
 // @Summary     Logout Device from WhatsApp Multi-Device
 // @Description Make Device Logout from WhatsApp Multi-Device
@@ -143,6 +336,7 @@ func LoginPair(c echo.Context) error {
 // @Produce     json
 // @Success     200
 // @Security    BearerAuth
+// @Security    APIKeyAuth
 // @Router      /logout [post]
 func Logout(c echo.Context) error {
 	var err error
@@ -156,6 +350,110 @@ func Logout(c echo.Context) error {
 	return router.ResponseSuccess(c, "Successfully Logged Out")
 }
 
+// GetAPIKeys
+// @Summary     Get API Keys
+// @Description Get Every API Key Bound to WhatsApp Account
+// @Tags        WhatsApp API Key
+// @Produce     json
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /apikey [get]
+func GetAPIKeys(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	apiKeys, err := pkgAuth.AuthAPIKeyList(jid)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get API Keys", apiKeys)
+}
+
+// CreateAPIKey
+// @Summary     Create API Key
+// @Description Create Long-Lived API Key Bound to WhatsApp Account, The Key is Only Shown Once
+// @Tags        WhatsApp API Key
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       name      formData  string  false  "API Key Name"
+// @Param       scopes    formData  string  true   "Comma Separated Scopes (send, group:read, group:write, session:admin, events:read)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /apikey [post]
+func CreateAPIKey(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	var reqAPIKey typWhatsApp.RequestAPIKey
+	reqAPIKey.Name = strings.TrimSpace(c.FormValue("name"))
+	reqAPIKey.Scopes = strings.TrimSpace(c.FormValue("scopes"))
+
+	if len(reqAPIKey.Scopes) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Scopes")
+	}
+
+	scopes, err := pkgAuth.AuthScopesParse(reqAPIKey.Scopes)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	// API Key Can Not Mint Another API Key With Wider Scopes
+	for _, scope := range scopes {
+		if !authScope(c, scope) {
+			return router.ResponseForbidden(c, "Missing Required Scope "+scope)
+		}
+	}
+
+	// API Key is Owned by The User Who Create It
+	// So It Can Be Revoked When The User is Changed
+	var username string
+	if callerAPIKey := pkgAuth.APIKeyGet(c); callerAPIKey != nil {
+		username = callerAPIKey.Username
+	} else {
+		jwtClaims := c.Get("user").(*jwt.Token).Claims.(*typAuth.AuthJWTClaims)
+
+		username, err = pkgAuth.AuthTokenUsername(jwtClaims.Id)
+		if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+	}
+
+	apiKey, key, err := pkgAuth.AuthAPIKeyCreate(jid, username, reqAPIKey.Name, scopes)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	var resAPIKey typWhatsApp.ResponseAPIKey
+	resAPIKey.AuthAPIKey = *apiKey
+	resAPIKey.Key = key
+
+	return router.ResponseSuccessWithData(c, "Successfully Create API Key", resAPIKey)
+}
+
+// RevokeAPIKey
+// @Summary     Revoke API Key
+// @Description Revoke API Key Bound to WhatsApp Account
+// @Tags        WhatsApp API Key
+// @Produce     json
+// @Param       id        path      string  true  "API Key ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /apikey/{id} [delete]
+func RevokeAPIKey(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	err := pkgAuth.AuthAPIKeyRevoke(jid, c.Param("id"))
+	if errors.Is(err, pkgAuth.ErrAuthAPIKeyNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Revoke API Key")
+}
+
 // Registered
 // @Summary     Check If WhatsApp Personal ID is Registered
 // @Description Check WhatsApp Personal ID is Registered
@@ -164,6 +462,7 @@ func Logout(c echo.Context) error {
 // @Param       msisdn    query  string  true  "WhatsApp Personal ID to Check"
 // @Success     200
 // @Security    BearerAuth
+// @Security    APIKeyAuth
 // @Router      /registered [get]
 func Registered(c echo.Context) error {
 	jid := jwtPayload(c).JID
@@ -181,19 +480,150 @@ func Registered(c echo.Context) error {
 	return router.ResponseSuccess(c, "WhatsApp Personal ID is Registered")
 }
 
+// RegisteredBatch
+// @Summary     Check If Multiple WhatsApp Personal ID Are Registered
+// @Description Check Multiple Phone Numbers Using Single Lookup, Result is Cached
+// @Description Phone Number is Normalized by Removing Space, Dash and Replacing Leading Zeros With Country Code
+// @Tags        WhatsApp Information
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn        formData  string  true   "Phone Numbers to Check (Comma Separated or Repeated)"
+// @Param       country_code  formData  string  false  "Default Country Code for Phone Number With Leading Zero"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /registered/batch [post]
+func RegisteredBatch(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	var err error
+
+	var reqRegisteredBatch typWhatsApp.RequestRegisteredBatch
+	reqRegisteredBatch.CountryCode = strings.TrimSpace(c.FormValue("country_code"))
+
+	reqRegisteredBatch.MSISDN, err = formValueList(c, "msisdn")
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	if len(reqRegisteredBatch.MSISDN) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value MSISDN")
+	}
+
+	if len(reqRegisteredBatch.MSISDN) > pkgWhatsApp.WhatsAppRegisteredBatchMax {
+		return router.ResponseBadRequest(c, "Form Value MSISDN Should Not Exceed "+strconv.Itoa(pkgWhatsApp.WhatsAppRegisteredBatchMax)+" Phone Numbers")
+	}
+
+	if len(reqRegisteredBatch.CountryCode) == 0 {
+		reqRegisteredBatch.CountryCode = pkgWhatsApp.WhatsAppDefaultCountryCode
+	}
+
+	// Normalize Every Phone Number First
+	// So Only Valid Phone Number is Checked to WhatsApp
+	var phones []string
+	resRegisteredBatch := make([]typWhatsApp.ResponseRegisteredBatch, len(reqRegisteredBatch.MSISDN))
+
+	for i, msisdn := range reqRegisteredBatch.MSISDN {
+		resRegisteredBatch[i].Input = msisdn
+
+		phone, err := pkgWhatsApp.WhatsAppNormalizePhone(msisdn, reqRegisteredBatch.CountryCode)
+		if err != nil {
+			resRegisteredBatch[i].Error = err.Error()
+			continue
+		}
+
+		resRegisteredBatch[i].Query = phone
+		phones = append(phones, phone)
+	}
+
+	if len(phones) > 0 {
+		registered, err := pkgWhatsApp.WhatsAppRegisteredCheck(jid, phones)
+		if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+
+		for i := range resRegisteredBatch {
+			if len(resRegisteredBatch[i].Query) > 0 {
+				resRegisteredBatch[i].RegisteredInfo = registered[resRegisteredBatch[i].Query]
+			}
+		}
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Check Registered WhatsApp Personal ID", resRegisteredBatch)
+}
+
 // GetGroup
 // @Summary     Get Joined Groups Information
 // @Description Get Joined Groups Information from WhatsApp
+// @Description Without Any Query Parameter Full Group Information List is Returned
+// @Description With Any Query Parameter Group Summary Page Sorted by Group ID is Returned
+// @Description Community Hierarchy is Shown by Parent Flag and Parent Community ID of Each Group Summary
 // @Tags        WhatsApp Group
 // @Produce     json
+// @Param       q       query  string   false  "Search Group Subject"
+// @Param       admin   query  bool     false  "Only Groups Where Account is Admin"
+// @Param       parent  query  string   false  "Only Groups Linked to Community ID"
+// @Param       after   query  string   false  "Cursor From Previous Page Next Cursor"
+// @Param       limit   query  integer  false  "Page Size (Default 50, Maximum 200)"
 // @Success     200
 // @Security    BearerAuth
+// @Security    APIKeyAuth
 // @Router      /group [get]
 func GetGroup(c echo.Context) error {
 	var err error
 	jid := jwtPayload(c).JID
 
-	group, err := pkgWhatsApp.WhatsAppGroupGet(jid)
+	// Keep Returning Full Group Information List
+	// When Search or Pagination is Not Requested
+	isPaged := false
+	for _, param := range []string{"q", "admin", "parent", "after", "limit"} {
+		if _, isExist := c.QueryParams()[param]; isExist {
+			isPaged = true
+			break
+		}
+	}
+
+	if !isPaged {
+		group, err := pkgWhatsApp.WhatsAppGroupGet(jid)
+		if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+
+		return router.ResponseSuccessWithData(c, "Successfully List Joined Groups", group)
+	}
+
+	var reqGroupList typWhatsApp.RequestGroupList
+	reqGroupList.Query = strings.TrimSpace(c.QueryParam("q"))
+	reqGroupList.After = strings.TrimSpace(c.QueryParam("after"))
+
+	reqGroupList.Parent = strings.TrimSpace(c.QueryParam("parent"))
+	if len(reqGroupList.Parent) > 0 {
+		reqGroupList.Parent = pkgWhatsApp.WhatsAppComposeJID(reqGroupList.Parent).String()
+	}
+
+	reqAdmin := strings.TrimSpace(c.QueryParam("admin"))
+	if len(reqAdmin) > 0 {
+		reqGroupList.IsAdmin, err = strconv.ParseBool(reqAdmin)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Admin to Boolean")
+		}
+	}
+
+	reqLimit := strings.TrimSpace(c.QueryParam("limit"))
+	if len(reqLimit) > 0 {
+		reqGroupList.Limit, err = strconv.Atoi(reqLimit)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Limit to Integer")
+		}
+	}
+
+	group, err := pkgWhatsApp.WhatsAppGroupList(jid, pkgWhatsApp.GroupQuery{
+		Query:   reqGroupList.Query,
+		IsAdmin: reqGroupList.IsAdmin,
+		Parent:  reqGroupList.Parent,
+		After:   reqGroupList.After,
+		Limit:   reqGroupList.Limit,
+	})
 	if err != nil {
 		return router.ResponseInternalError(c, err.Error())
 	}
@@ -201,6 +631,155 @@ func GetGroup(c echo.Context) error {
 	return router.ResponseSuccessWithData(c, "Successfully List Joined Groups", group)
 }
 
+// GetGroupDetail
+// @Summary     Get Group Information
+// @Description Get Group Information By Group ID Including Participants With Admin Flags and LID
+// @Tags        WhatsApp Group
+// @Produce     json
+// @Param       gid  path  string  true  "Group ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid} [get]
+func GetGroupDetail(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	group, err := pkgWhatsApp.WhatsAppGroupInfo(jid, strings.TrimSpace(c.Param("gid")))
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Group Information", group)
+}
+
+// GetGroupHistory
+// @Summary     Get Group History
+// @Description Get Group Membership and Setting Changes Recorded While Account is in The Group
+// @Description Ordered From The Newest Entry, Use Next Cursor as Before Cursor for Next Page
+// @Tags        WhatsApp Group
+// @Produce     json
+// @Param       gid     path   string   true   "Group ID"
+// @Param       action  query  string   false  "Filter by Action"  Enums(joined, join, add, leave, remove, promote, demote, subject, description, locked, announce, disappearing, invite_link, link, unlink, delete)
+// @Param       before  query  integer  false  "Cursor From Previous Page Next Cursor"
+// @Param       limit   query  integer  false  "Page Size (Default 50, Maximum 200)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/history [get]
+func GetGroupHistory(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupHistory typWhatsApp.RequestGroupHistory
+	reqGroupHistory.Action = strings.TrimSpace(c.QueryParam("action"))
+
+	reqBefore := strings.TrimSpace(c.QueryParam("before"))
+	if len(reqBefore) > 0 {
+		reqGroupHistory.Before, err = strconv.ParseInt(reqBefore, 10, 64)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Before Cursor to Integer")
+		}
+	}
+
+	reqLimit := strings.TrimSpace(c.QueryParam("limit"))
+	if len(reqLimit) > 0 {
+		reqGroupHistory.Limit, err = strconv.Atoi(reqLimit)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Limit to Integer")
+		}
+	}
+
+	historyPage, err := pkgWhatsApp.WhatsAppGroupHistory(jid, strings.TrimSpace(c.Param("gid")), pkgWhatsApp.GroupHistoryQuery{
+		Action: reqGroupHistory.Action,
+		Before: reqGroupHistory.Before,
+		Limit:  reqGroupHistory.Limit,
+	})
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Group History", historyPage)
+}
+
+// GetGroupRequests
+// @Summary     Get Group Join Requests
+// @Description Get Pending Join Requests By Group ID for Group in Membership Approval Mode
+// @Tags        WhatsApp Group
+// @Produce     json
+// @Param       gid  path  string  true  "Group ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/requests [get]
+func GetGroupRequests(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	requests, err := pkgWhatsApp.WhatsAppGroupRequests(jid, strings.TrimSpace(c.Param("gid")))
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully List Group Join Requests", requests)
+}
+
+// ApproveGroupRequests
+// @Summary     Approve Group Join Requests
+// @Description Approve Pending Join Requests By Group ID, Result Code is Returned for Each Requester
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid           path      string  true  "Group ID"
+// @Param       participants  formData  string  true  "Requester JIDs (Comma Separated or Repeated)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/requests/approve [post]
+func ApproveGroupRequests(c echo.Context) error {
+	return updateGroupRequests(c, pkgWhatsApp.GroupRequestApprove)
+}
+
+// RejectGroupRequests
+// @Summary     Reject Group Join Requests
+// @Description Reject Pending Join Requests By Group ID, Result Code is Returned for Each Requester
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid           path      string  true  "Group ID"
+// @Param       participants  formData  string  true  "Requester JIDs (Comma Separated or Repeated)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/requests/reject [post]
+func RejectGroupRequests(c echo.Context) error {
+	return updateGroupRequests(c, pkgWhatsApp.GroupRequestReject)
+}
+
+func updateGroupRequests(c echo.Context, action string) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupParticipants typWhatsApp.RequestGroupParticipants
+	reqGroupParticipants.GID = strings.TrimSpace(c.Param("gid"))
+
+	reqGroupParticipants.Participants, err = formValueList(c, "participants")
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	if len(reqGroupParticipants.Participants) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Participants")
+	}
+
+	requests, err := pkgWhatsApp.WhatsAppGroupRequestsUpdate(jid, reqGroupParticipants.GID, reqGroupParticipants.Participants, action)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Update Group Join Requests", requests)
+}
+
 // JoinGroup
 // @Summary     Join Group From Invitation Link
 // @Description Joining to Group From Invitation Link from WhatsApp
@@ -209,6 +788,7 @@ func GetGroup(c echo.Context) error {
 // @Param       link    formData  string  true  "Group Invitation Link"
 // @Success     200
 // @Security    BearerAuth
+// @Security    APIKeyAuth
 // @Router      /group/join [post]
 func JoinGroup(c echo.Context) error {
 	var err error
@@ -237,6 +817,7 @@ func JoinGroup(c echo.Context) error {
 // @Param       groupid    formData  string  true  "Group ID"
 // @Success     200
 // @Security    BearerAuth
+// @Security    APIKeyAuth
 // @Router      /group/leave [post]
 func LeaveGroup(c echo.Context) error {
 	var err error
@@ -257,80 +838,2370 @@ func LeaveGroup(c echo.Context) error {
 	return router.ResponseSuccess(c, "Successfully Leave Group By Group ID")
 }
 
-// SendText
-// @Summary     Send Text Message
-// @Description Send Text Message to Spesific WhatsApp Personal ID or Group ID
-// @Tags        WhatsApp Send Message
-// @Accept      multipart/form-data
+// PreviewGroupInvite
+// @Summary     Preview Group From Invitation
+// @Description Get Group Subject, Size and Owner From Invitation Link Without Joining
+// @Description Direct Invitation Message Can be Previewed Using Group ID, Inviter, Code and Expiration Instead of Link
+// @Tags        WhatsApp Group
 // @Produce     json
-// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
-// @Param       message   formData  string  true  "Text Message"
+// @Param       link        query  string   false  "Group Invitation Link"
+// @Param       groupid     query  string   false  "Group ID From Invitation Message"
+// @Param       inviter     query  string   false  "Inviter WhatsApp Personal ID From Invitation Message"
+// @Param       code        query  string   false  "Invite Code From Invitation Message"
+// @Param       expiration  query  integer  false  "Invite Expiration Unix Timestamp From Invitation Message"
 // @Success     200
 // @Security    BearerAuth
-// @Router      /send/text [post]
-func SendText(c echo.Context) error {
+// @Security    APIKeyAuth
+// @Router      /group/invite/preview [get]
+func PreviewGroupInvite(c echo.Context) error {
 	var err error
 	jid := jwtPayload(c).JID
 
-	var reqSendMessage typWhatsApp.RequestSendMessage
-	reqSendMessage.RJID = strings.TrimSpace(c.FormValue("msisdn"))
-	reqSendMessage.Message = strings.TrimSpace(c.FormValue("message"))
+	var reqGroupInvite typWhatsApp.RequestGroupInvite
+	reqGroupInvite.Link = strings.TrimSpace(c.QueryParam("link"))
 
-	if len(reqSendMessage.RJID) == 0 {
-		return router.ResponseBadRequest(c, "Missing Form Value MSISDN")
+	if len(reqGroupInvite.Link) > 0 {
+		group, err := pkgWhatsApp.WhatsAppGroupInvitePreview(jid, reqGroupInvite.Link)
+		if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+
+		return router.ResponseSuccessWithData(c, "Successfully Preview Group From Invitation Link", group)
 	}
 
-	if len(reqSendMessage.Message) == 0 {
-		return router.ResponseBadRequest(c, "Missing Form Value Message")
+	reqGroupInvite.GID = strings.TrimSpace(c.QueryParam("groupid"))
+	reqGroupInvite.Inviter = strings.TrimSpace(c.QueryParam("inviter"))
+	reqGroupInvite.Code = strings.TrimSpace(c.QueryParam("code"))
+
+	if len(reqGroupInvite.Code) == 0 {
+		return router.ResponseBadRequest(c, "Missing Query Value Link or Code")
 	}
 
-	var resSendMessage typWhatsApp.ResponseSendMessage
-	resSendMessage.MsgID, err = pkgWhatsApp.WhatsAppSendText(c.Request().Context(), jid, reqSendMessage.RJID, reqSendMessage.Message)
+	err = composeGroupInvite(&reqGroupInvite, c.QueryParam("expiration"))
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	group, err := pkgWhatsApp.WhatsAppGroupInviteMessagePreview(jid, reqGroupInvite.GID, reqGroupInvite.Inviter, reqGroupInvite.Code, reqGroupInvite.Expiration)
 	if err != nil {
 		return router.ResponseInternalError(c, err.Error())
 	}
 
-	return router.ResponseSuccessWithData(c, "Successfully Send Text Message", resSendMessage)
+	return router.ResponseSuccessWithData(c, "Successfully Preview Group From Invitation Message", group)
 }
 
-// SendLocation
-// @Summary     Send Location Message
-// @Description Send Location Message to Spesific WhatsApp Personal ID or Group ID
+// JoinGroupInvite
+// @Summary     Join Group From Invitation Message
+// @Description Joining to Group From Direct Invitation Message Using Invite Code and Expiration
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       groupid     formData  string   true   "Group ID From Invitation Message"
+// @Param       inviter     formData  string   true   "Inviter WhatsApp Personal ID From Invitation Message"
+// @Param       code        formData  string   true   "Invite Code From Invitation Message"
+// @Param       expiration  formData  integer  false  "Invite Expiration Unix Timestamp From Invitation Message"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/join/invite [post]
+func JoinGroupInvite(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupInvite typWhatsApp.RequestGroupInvite
+	reqGroupInvite.GID = strings.TrimSpace(c.FormValue("groupid"))
+	reqGroupInvite.Inviter = strings.TrimSpace(c.FormValue("inviter"))
+	reqGroupInvite.Code = strings.TrimSpace(c.FormValue("code"))
+
+	if len(reqGroupInvite.Code) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Code")
+	}
+
+	err = composeGroupInvite(&reqGroupInvite, c.FormValue("expiration"))
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	group, err := pkgWhatsApp.WhatsAppGroupJoinInvite(jid, reqGroupInvite.GID, reqGroupInvite.Inviter, reqGroupInvite.Code, reqGroupInvite.Expiration)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Joined Group From Invitation Message", group)
+}
+
+func composeGroupInvite(reqGroupInvite *typWhatsApp.RequestGroupInvite, expiration string) error {
+	var err error
+
+	if len(reqGroupInvite.GID) == 0 {
+		return errors.New("Missing Value Group ID")
+	}
+
+	if len(reqGroupInvite.Inviter) == 0 {
+		return errors.New("Missing Value Inviter")
+	}
+
+	expiration = strings.TrimSpace(expiration)
+	if len(expiration) > 0 {
+		reqGroupInvite.Expiration, err = strconv.ParseInt(expiration, 10, 64)
+		if err != nil {
+			return errors.New("Error While Decoding Expiration to Integer")
+		}
+	}
+
+	return nil
+}
+
+// GetGroupInvite
+// @Summary     Get Group Invitation Link
+// @Description Get Current Group Invitation Link By Group ID, Only Group Admin is Allowed
+// @Tags        WhatsApp Group
+// @Produce     json
+// @Param       gid  path  string  true  "Group ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/invite [get]
+func GetGroupInvite(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	link, err := pkgWhatsApp.WhatsAppGroupInviteLink(jid, strings.TrimSpace(c.Param("gid")), false)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Group Invitation Link", link)
+}
+
+// ResetGroupInvite
+// @Summary     Reset Group Invitation Link
+// @Description Revoke Current Group Invitation Link and Generate New One By Group ID, Only Group Admin is Allowed
+// @Tags        WhatsApp Group
+// @Produce     json
+// @Param       gid  path  string  true  "Group ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/invite/reset [post]
+func ResetGroupInvite(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	link, err := pkgWhatsApp.WhatsAppGroupInviteLink(jid, strings.TrimSpace(c.Param("gid")), true)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Reset Group Invitation Link", link)
+}
+
+// CreateGroup
+// @Summary     Create Group
+// @Description Create Group With Initial Participants, Result Code is Returned for Each Participant
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       name          formData  string  true   "Group Subject"
+// @Param       participants  formData  string  true   "Participant WhatsApp Personal IDs (Comma Separated or Repeated)"
+// @Param       parent        formData  string  false  "Community ID to Create Group Inside Community"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group [post]
+func CreateGroup(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupCreate typWhatsApp.RequestGroupCreate
+	reqGroupCreate.Name = strings.TrimSpace(c.FormValue("name"))
+	reqGroupCreate.Parent = strings.TrimSpace(c.FormValue("parent"))
+
+	reqGroupCreate.Participants, err = formValueList(c, "participants")
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	if len(reqGroupCreate.Name) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Name")
+	}
+
+	if len(reqGroupCreate.Participants) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Participants")
+	}
+
+	group, err := pkgWhatsApp.WhatsAppGroupCreate(jid, reqGroupCreate.Name, reqGroupCreate.Participants, reqGroupCreate.Parent)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Create Group", group)
+}
+
+// AddGroupParticipants
+// @Summary     Add Group Participants
+// @Description Add Participants to Group, Result Code is Returned for Each Participant
+// @Description Participant With Privacy Settings Returns Code 403 With Invite Code
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid           path      string  true  "Group ID"
+// @Param       participants  formData  string  true  "Participant WhatsApp Personal IDs (Comma Separated or Repeated)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/participants/add [post]
+func AddGroupParticipants(c echo.Context) error {
+	return updateGroupParticipants(c, pkgWhatsApp.GroupParticipantAdd)
+}
+
+// RemoveGroupParticipants
+// @Summary     Remove Group Participants
+// @Description Remove Participants From Group, Result Code is Returned for Each Participant
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid           path      string  true  "Group ID"
+// @Param       participants  formData  string  true  "Participant WhatsApp Personal IDs (Comma Separated or Repeated)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/participants/remove [post]
+func RemoveGroupParticipants(c echo.Context) error {
+	return updateGroupParticipants(c, pkgWhatsApp.GroupParticipantRemove)
+}
+
+// PromoteGroupParticipants
+// @Summary     Promote Group Participants
+// @Description Promote Participants to Group Admin, Result Code is Returned for Each Participant
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid           path      string  true  "Group ID"
+// @Param       participants  formData  string  true  "Participant WhatsApp Personal IDs (Comma Separated or Repeated)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/participants/promote [post]
+func PromoteGroupParticipants(c echo.Context) error {
+	return updateGroupParticipants(c, pkgWhatsApp.GroupParticipantPromote)
+}
+
+// DemoteGroupParticipants
+// @Summary     Demote Group Participants
+// @Description Demote Participants From Group Admin, Result Code is Returned for Each Participant
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid           path      string  true  "Group ID"
+// @Param       participants  formData  string  true  "Participant WhatsApp Personal IDs (Comma Separated or Repeated)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/participants/demote [post]
+func DemoteGroupParticipants(c echo.Context) error {
+	return updateGroupParticipants(c, pkgWhatsApp.GroupParticipantDemote)
+}
+
+func updateGroupParticipants(c echo.Context, action string) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupParticipants typWhatsApp.RequestGroupParticipants
+	reqGroupParticipants.GID = strings.TrimSpace(c.Param("gid"))
+
+	reqGroupParticipants.Participants, err = formValueList(c, "participants")
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	if len(reqGroupParticipants.Participants) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Participants")
+	}
+
+	participants, err := pkgWhatsApp.WhatsAppGroupParticipants(jid, reqGroupParticipants.GID, reqGroupParticipants.Participants, action)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Update Group Participants", participants)
+}
+
+// SetGroupSubject
+// @Summary     Change Group Subject
+// @Description Change Group Subject By Group ID
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid      path      string  true  "Group ID"
+// @Param       subject  formData  string  true  "Group Subject"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/subject [post]
+func SetGroupSubject(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupInfo typWhatsApp.RequestGroupInfo
+	reqGroupInfo.GID = strings.TrimSpace(c.Param("gid"))
+	reqGroupInfo.Subject = strings.TrimSpace(c.FormValue("subject"))
+
+	if len(reqGroupInfo.Subject) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Subject")
+	}
+
+	err = pkgWhatsApp.WhatsAppGroupSetName(jid, reqGroupInfo.GID, reqGroupInfo.Subject)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Change Group Subject")
+}
+
+// SetGroupDescription
+// @Summary     Change Group Description
+// @Description Change Group Description By Group ID, Empty Description Removes It
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid          path      string  true   "Group ID"
+// @Param       description  formData  string  false  "Group Description"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/description [post]
+func SetGroupDescription(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupInfo typWhatsApp.RequestGroupInfo
+	reqGroupInfo.GID = strings.TrimSpace(c.Param("gid"))
+	reqGroupInfo.Description = strings.TrimSpace(c.FormValue("description"))
+
+	err = pkgWhatsApp.WhatsAppGroupSetTopic(jid, reqGroupInfo.GID, reqGroupInfo.Description)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	if len(reqGroupInfo.Description) == 0 {
+		return router.ResponseSuccess(c, "Successfully Remove Group Description")
+	}
+
+	return router.ResponseSuccess(c, "Successfully Change Group Description")
+}
+
+// SetGroupPhoto
+// @Summary     Change Group Photo
+// @Description Change Group Photo By Group ID, Photo is Cropped to Square JPEG
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid    path      string  true  "Group ID"
+// @Param       photo  formData  file    true  "Group Photo"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/photo [post]
+func SetGroupPhoto(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	gid := strings.TrimSpace(c.Param("gid"))
+
+	fileStream, _, err := c.Request().FormFile("photo")
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	// Don't Forget to Close The File Stream
+	defer fileStream.Close()
+
+	fileBytes, err := convertFileToBytes(fileStream)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	if len(fileBytes) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form File Photo")
+	}
+
+	pictureID, err := pkgWhatsApp.WhatsAppGroupSetPhoto(jid, gid, fileBytes)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Change Group Photo", pictureID)
+}
+
+// DeleteGroupPhoto
+// @Summary     Remove Group Photo
+// @Description Remove Group Photo By Group ID
+// @Tags        WhatsApp Group
+// @Produce     json
+// @Param       gid  path  string  true  "Group ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/photo [delete]
+func DeleteGroupPhoto(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	_, err = pkgWhatsApp.WhatsAppGroupSetPhoto(jid, strings.TrimSpace(c.Param("gid")), nil)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Remove Group Photo")
+}
+
+// SetGroupAnnounce
+// @Summary     Toggle Group Announce Mode
+// @Description Toggle Group Announce Mode By Group ID, Only Admins Can Send Message When Enabled
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid      path      string  true  "Group ID"
+// @Param       enabled  formData  bool    true  "Enable Announce Mode"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/announce [post]
+func SetGroupAnnounce(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupSetting typWhatsApp.RequestGroupSetting
+	reqGroupSetting.GID = strings.TrimSpace(c.Param("gid"))
+
+	reqGroupSetting.Enabled, err = strconv.ParseBool(strings.TrimSpace(c.FormValue("enabled")))
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Enabled to Boolean")
+	}
+
+	err = pkgWhatsApp.WhatsAppGroupSetAnnounce(jid, reqGroupSetting.GID, reqGroupSetting.Enabled)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Change Group Announce Mode")
+}
+
+// SetGroupLocked
+// @Summary     Toggle Group Locked Mode
+// @Description Toggle Group Locked Mode By Group ID, Only Admins Can Edit Group Information When Enabled
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid      path      string  true  "Group ID"
+// @Param       enabled  formData  bool    true  "Enable Locked Mode"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/locked [post]
+func SetGroupLocked(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupSetting typWhatsApp.RequestGroupSetting
+	reqGroupSetting.GID = strings.TrimSpace(c.Param("gid"))
+
+	reqGroupSetting.Enabled, err = strconv.ParseBool(strings.TrimSpace(c.FormValue("enabled")))
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Enabled to Boolean")
+	}
+
+	err = pkgWhatsApp.WhatsAppGroupSetLocked(jid, reqGroupSetting.GID, reqGroupSetting.Enabled)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Change Group Locked Mode")
+}
+
+// SetGroupDisappearing
+// @Summary     Set Group Disappearing Message Timer
+// @Description Set Group Disappearing Message Timer By Group ID
+// @Tags        WhatsApp Group
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       gid    path      string  true  "Group ID"
+// @Param       timer  formData  string  true  "Disappearing Timer (off, 24h, 7d, 90d)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /group/{gid}/disappearing [post]
+func SetGroupDisappearing(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupSetting typWhatsApp.RequestGroupSetting
+	reqGroupSetting.GID = strings.TrimSpace(c.Param("gid"))
+	reqGroupSetting.Timer = strings.TrimSpace(c.FormValue("timer"))
+
+	if len(reqGroupSetting.Timer) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Timer")
+	}
+
+	timer, err := pkgWhatsApp.WhatsAppGroupParseDisappearing(reqGroupSetting.Timer)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	err = pkgWhatsApp.WhatsAppGroupSetDisappearing(jid, reqGroupSetting.GID, timer)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Set Group Disappearing Message Timer")
+}
+
+// CreateCommunity
+// @Summary     Create Community
+// @Description Create Community With Optional Initial Participants, Announcement Group is Created Automatically
+// @Tags        WhatsApp Community
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       name          formData  string  true   "Community Subject"
+// @Param       participants  formData  string  false  "Participant WhatsApp Personal IDs (Comma Separated or Repeated)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /community [post]
+func CreateCommunity(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqGroupCreate typWhatsApp.RequestGroupCreate
+	reqGroupCreate.Name = strings.TrimSpace(c.FormValue("name"))
+
+	reqGroupCreate.Participants, err = formValueList(c, "participants")
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	if len(reqGroupCreate.Name) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Name")
+	}
+
+	community, err := pkgWhatsApp.WhatsAppCommunityCreate(jid, reqGroupCreate.Name, reqGroupCreate.Participants)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Create Community", community)
+}
+
+// GetCommunitySubGroups
+// @Summary     Get Community Linked Groups
+// @Description Get Groups Linked to Community Including Its Announcement Group
+// @Tags        WhatsApp Community
+// @Produce     json
+// @Param       cid  path  string  true  "Community ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /community/{cid}/subgroups [get]
+func GetCommunitySubGroups(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	subGroups, err := pkgWhatsApp.WhatsAppCommunitySubGroups(jid, strings.TrimSpace(c.Param("cid")))
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully List Community Linked Groups", subGroups)
+}
+
+// LinkCommunityGroup
+// @Summary     Link Group to Community
+// @Description Link Existing Group to Community By Community ID
+// @Tags        WhatsApp Community
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       cid      path      string  true  "Community ID"
+// @Param       groupid  formData  string  true  "Group ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /community/{cid}/link [post]
+func LinkCommunityGroup(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqCommunityLink typWhatsApp.RequestCommunityLink
+	reqCommunityLink.CID = strings.TrimSpace(c.Param("cid"))
+	reqCommunityLink.GID = strings.TrimSpace(c.FormValue("groupid"))
+
+	if len(reqCommunityLink.GID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Group ID")
+	}
+
+	err = pkgWhatsApp.WhatsAppCommunityLink(jid, reqCommunityLink.CID, reqCommunityLink.GID)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Link Group to Community")
+}
+
+// UnlinkCommunityGroup
+// @Summary     Unlink Group From Community
+// @Description Unlink Group From Community By Community ID
+// @Tags        WhatsApp Community
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       cid      path      string  true  "Community ID"
+// @Param       groupid  formData  string  true  "Group ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /community/{cid}/unlink [post]
+func UnlinkCommunityGroup(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqCommunityLink typWhatsApp.RequestCommunityLink
+	reqCommunityLink.CID = strings.TrimSpace(c.Param("cid"))
+	reqCommunityLink.GID = strings.TrimSpace(c.FormValue("groupid"))
+
+	if len(reqCommunityLink.GID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Group ID")
+	}
+
+	err = pkgWhatsApp.WhatsAppCommunityUnlink(jid, reqCommunityLink.CID, reqCommunityLink.GID)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Unlink Group From Community")
+}
+
+// SendCommunityAnnouncement
+// @Summary     Send Community Announcement
+// @Description Send Message to Community Announcement Group By Community ID
+// @Description Other Form Values Are The Same as The Send Message Endpoint of Selected Type
+// @Tags        WhatsApp Community
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       cid      path      string  true   "Community ID"
+// @Param       type     formData  string  false  "Message Type"  Enums(text, location, link, poll, contact, document, image, audio, video, sticker)  default(text)
+// @Param       message  formData  string  false  "Text Message"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /community/{cid}/announce [post]
+func SendCommunityAnnouncement(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	messageType := strings.TrimSpace(c.FormValue("type"))
+	if len(messageType) == 0 {
+		messageType = pkgWhatsApp.QueueTypeText
+	}
+
+	msg, err := composeMessage(c, messageType)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	msg.RJID, err = pkgWhatsApp.WhatsAppCommunityAnnouncement(jid, strings.TrimSpace(c.Param("cid")))
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return sendMessage(c, jid, "Community Announcement", msg)
+}
+
+// SendText
+// @Summary     Send Text Message
+// @Description Send Text Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       message   formData  string  true  "Text Message"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/text [post]
+func SendText(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	msg, err := composeSendMessage(c, pkgWhatsApp.QueueTypeText)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return sendMessage(c, jid, "Text", msg)
+}
+
+func composeSendText(c echo.Context) (*pkgWhatsApp.QueueMessage, error) {
+	var reqSendMessage typWhatsApp.RequestSendMessage
+	reqSendMessage.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+	reqSendMessage.Message = strings.TrimSpace(c.FormValue("message"))
+
+	if len(reqSendMessage.Message) == 0 {
+		return nil, errors.New("Missing Form Value Message")
+	}
+
+	return &pkgWhatsApp.QueueMessage{
+		Type: pkgWhatsApp.QueueTypeText,
+		RJID: reqSendMessage.RJID,
+		Text: reqSendMessage.Message,
+	}, nil
+}
+
+// SendLocation
+// @Summary     Send Location Message
+// @Description Send Location Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       latitude  formData  number  true  "Location Latitude"
+// @Param       longitude formData  number  true  "Location Longitude"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/location [post]
+func SendLocation(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	msg, err := composeSendMessage(c, pkgWhatsApp.QueueTypeLocation)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return sendMessage(c, jid, "Location", msg)
+}
+
+func composeSendLocation(c echo.Context) (*pkgWhatsApp.QueueMessage, error) {
+	var err error
+
+	var reqSendLocation typWhatsApp.RequestSendLocation
+	reqSendLocation.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+
+	reqSendLocation.Latitude, err = strconv.ParseFloat(strings.TrimSpace(c.FormValue("latitude")), 64)
+	if err != nil {
+		return nil, errors.New("Error While Decoding Latitude to Float64")
+	}
+
+	reqSendLocation.Longitude, err = strconv.ParseFloat(strings.TrimSpace(c.FormValue("longitude")), 64)
+	if err != nil {
+		return nil, errors.New("Error While Decoding Longitude to Float64")
+	}
+
+	return &pkgWhatsApp.QueueMessage{
+		Type:      pkgWhatsApp.QueueTypeLocation,
+		RJID:      reqSendLocation.RJID,
+		Latitude:  reqSendLocation.Latitude,
+		Longitude: reqSendLocation.Longitude,
+	}, nil
+}
+
+// SendContact
+// @Summary     Send Contact Message
+// @Description Send Contact Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn    formData  string  true   "Destination WhatsApp Personal ID or Group ID"
+// @Param       name      formData  string  false  "Contact Name (Repeat With Phone for Multiple Contacts)"
+// @Param       phone     formData  string  false  "Contact Phone (Repeat With Name for Multiple Contacts)"
+// @Param       vcard     formData  string  false  "Raw vCard 3.0 or 4.0 Content (Can Contain Multiple Contacts)"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/contact [post]
+func SendContact(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	msg, err := composeSendMessage(c, pkgWhatsApp.QueueTypeContact)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return sendMessage(c, jid, "Contact", msg)
+}
+
+func composeSendContact(c echo.Context) (*pkgWhatsApp.QueueMessage, error) {
+	formParams, err := c.FormParams()
+	if err != nil {
+		return nil, err
+	}
+
+	var reqSendContact typWhatsApp.RequestSendContact
+	reqSendContact.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+	reqSendContact.Name = formParams["name"]
+	reqSendContact.Phone = formParams["phone"]
+	reqSendContact.VCard = formParams["vcard"]
+
+	if len(reqSendContact.Name) != len(reqSendContact.Phone) {
+		return nil, errors.New("Form Value Name and Phone Should be in Pair")
+	}
+
+	// Compose Contact List From Name and Phone Pair
+	var contacts []pkgWhatsApp.VCard
+	for i := range reqSendContact.Name {
+		contactName := strings.TrimSpace(reqSendContact.Name[i])
+		contactPhone := pkgWhatsApp.VCardPhoneDigits(reqSendContact.Phone[i])
+
+		if len(contactName) == 0 {
+			return nil, errors.New("Missing Form Value Name")
+		}
+
+		if len(contactPhone) == 0 {
+			return nil, errors.New("Missing Form Value Phone")
+		}
+
+		contacts = append(contacts, pkgWhatsApp.VCard{
+			Name: contactName,
+			Phones: []pkgWhatsApp.VCardPhone{
+				{Number: contactPhone},
+			},
+		})
+	}
+
+	// Append Contact List From Raw vCard Content
+	for _, vcard := range reqSendContact.VCard {
+		if len(strings.TrimSpace(vcard)) == 0 {
+			continue
+		}
+
+		vcardContacts, err := pkgWhatsApp.ParseVCard(vcard)
+		if err != nil {
+			return nil, err
+		}
+
+		contacts = append(contacts, vcardContacts...)
+	}
+
+	if len(contacts) == 0 {
+		return nil, errors.New("Missing Form Value Name and Phone or vCard")
+	}
+
+	return &pkgWhatsApp.QueueMessage{
+		Type:     pkgWhatsApp.QueueTypeContact,
+		RJID:     reqSendContact.RJID,
+		Contacts: contacts,
+	}, nil
+}
+
+// SendLink
+// @Summary     Send Link Message
+// @Description Send Link Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn    formData  string  true   "Destination WhatsApp Personal ID or Group ID"
+// @Param       caption   formData  string  false  "Link Caption"
+// @Param       url       formData  string  true   "Link URL"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/link [post]
+func SendLink(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	msg, err := composeSendMessage(c, pkgWhatsApp.QueueTypeLink)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return sendMessage(c, jid, "Link", msg)
+}
+
+func composeSendLink(c echo.Context) (*pkgWhatsApp.QueueMessage, error) {
+	var reqSendLink typWhatsApp.RequestSendLink
+	reqSendLink.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+	reqSendLink.Caption = strings.TrimSpace(c.FormValue("caption"))
+	reqSendLink.URL = strings.TrimSpace(c.FormValue("url"))
+
+	if len(reqSendLink.URL) == 0 {
+		return nil, errors.New("Missing Form Value URL")
+	}
+
+	return &pkgWhatsApp.QueueMessage{
+		Type: pkgWhatsApp.QueueTypeLink,
+		RJID: reqSendLink.RJID,
+		Text: reqSendLink.Caption,
+		URL:  reqSendLink.URL,
+	}, nil
+}
+
+// SendPoll
+// @Summary     Send Poll
+// @Description Send Poll to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn       formData  string  true   "Destination WhatsApp Personal ID or Group ID"
+// @Param       question     formData  string  true   "Poll Question"
+// @Param       options      formData  string  true   "Poll Options (Comma Seperated for New Options)"
+// @Param       multianswer  formData  bool    false  "Is Multiple Answer"  default(false)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/poll [post]
+func SendPoll(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	msg, err := composeSendMessage(c, pkgWhatsApp.QueueTypePoll)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return sendMessage(c, jid, "Poll", msg)
+}
+
+func composeSendPoll(c echo.Context) (*pkgWhatsApp.QueueMessage, error) {
+	var err error
+
+	var reqSendPoll typWhatsApp.RequestSendPoll
+	reqSendPoll.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+	reqSendPoll.Question = strings.TrimSpace(c.FormValue("question"))
+	reqSendPoll.Options = strings.TrimSpace(c.FormValue("options"))
+
+	if len(reqSendPoll.Question) == 0 {
+		return nil, errors.New("Missing Form Value Question")
+	}
+
+	if len(reqSendPoll.Options) == 0 {
+		return nil, errors.New("Missing Form Value Options")
+	}
+
+	isMultiAnswer := strings.TrimSpace(c.FormValue("multianswer"))
+	if len(isMultiAnswer) > 0 {
+		reqSendPoll.MultiAnswer, err = strconv.ParseBool(isMultiAnswer)
+		if err != nil {
+			return nil, errors.New("Error While Decoding Multi Answer to Boolean")
+		}
+	}
+
+	// Split Poll Options by Comma
+	// And Make Sure There is No Empty or Duplicate Option
+	var pollOptions []string
+	pollOptionsExist := make(map[string]bool)
+
+	for _, option := range strings.Split(reqSendPoll.Options, ",") {
+		option = strings.TrimSpace(option)
+		if len(option) == 0 {
+			continue
+		}
+
+		if pollOptionsExist[option] {
+			return nil, errors.New("Duplicate Poll Option " + option)
+		}
+
+		pollOptionsExist[option] = true
+		pollOptions = append(pollOptions, option)
+	}
+
+	return &pkgWhatsApp.QueueMessage{
+		Type:          pkgWhatsApp.QueueTypePoll,
+		RJID:          reqSendPoll.RJID,
+		Text:          reqSendPoll.Question,
+		Options:       pollOptions,
+		IsMultiAnswer: reqSendPoll.MultiAnswer,
+	}, nil
+}
+
+// SendBulk
+// @Summary     Send Bulk Message
+// @Description Send Same Message to Multiple Recipients in Background and Return Bulk Message ID
+// @Description Recipients Can be JSON Array of Object With MSISDN and Template Variables or CSV File With MSISDN Column
+// @Description Message Text, Caption or Poll Question Can Use {{variable}} Template From Recipient Variables
+// @Description Other Form Values Are The Same as The Send Message Endpoint of Selected Type
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       type        formData  string  false  "Message Type"  Enums(text, location, link, poll, contact, document, image, audio, video, sticker)  default(text)
+// @Param       recipients  formData  string  false  "JSON Array of Recipient Object With MSISDN and Template Variables"
+// @Param       csv         formData  file    false  "CSV File of Recipients With Header Row and MSISDN Column"
+// @Param       message     formData  string  false  "Text Message Template"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/bulk [post]
+func SendBulk(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	var reqSendBulk typWhatsApp.RequestSendBulk
+	reqSendBulk.Type = strings.TrimSpace(c.FormValue("type"))
+	reqSendBulk.Recipients = strings.TrimSpace(c.FormValue("recipients"))
+
+	if len(reqSendBulk.Type) == 0 {
+		reqSendBulk.Type = pkgWhatsApp.QueueTypeText
+	}
+
+	recipients, err := composeBulkRecipients(c, reqSendBulk.Recipients)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	msg, err := composeMessage(c, reqSendBulk.Type)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	bulk, err := pkgWhatsApp.WhatsAppBulkCreate(jid, msg, recipients)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Create Bulk Message", bulk)
+}
+
+// composeBulkRecipients Parse Bulk Message Recipients From JSON Form Value or CSV File
+// Every Field Other Than MSISDN is Used as Template Variable
+func composeBulkRecipients(c echo.Context, recipientsJSON string) ([]pkgWhatsApp.BulkRecipientInput, error) {
+	var records []map[string]string
+
+	if len(recipientsJSON) > 0 {
+		var items []map[string]interface{}
+
+		decoder := json.NewDecoder(strings.NewReader(recipientsJSON))
+		decoder.UseNumber()
+
+		err := decoder.Decode(&items)
+		if err != nil {
+			return nil, errors.New("Error While Decoding Recipients JSON: " + err.Error())
+		}
+
+		for _, item := range items {
+			record := make(map[string]string)
+			for key, value := range item {
+				record[strings.ToLower(strings.TrimSpace(key))] = fmt.Sprint(value)
+			}
+
+			records = append(records, record)
+		}
+	} else {
+		fileStream, _, err := c.Request().FormFile("csv")
+		if err != nil {
+			return nil, errors.New("Missing Form Value Recipients or CSV")
+		}
+
+		// Don't Forget to Close The File Stream
+		defer fileStream.Close()
+
+		csvReader := csv.NewReader(fileStream)
+		csvReader.FieldsPerRecord = -1
+		csvReader.TrimLeadingSpace = true
+
+		csvRows, err := csvReader.ReadAll()
+		if err != nil {
+			return nil, errors.New("Error While Decoding Recipients CSV: " + err.Error())
+		}
+
+		if len(csvRows) == 0 {
+			return nil, errors.New("Recipients CSV Should Have Header Row")
+		}
+
+		header := csvRows[0]
+		for i := range header {
+			header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff")))
+		}
+
+		for _, csvRow := range csvRows[1:] {
+			record := make(map[string]string)
+			for i, value := range csvRow {
+				if i < len(header) && len(header[i]) > 0 {
+					record[header[i]] = strings.TrimSpace(value)
+				}
+			}
+
+			records = append(records, record)
+		}
+	}
+
+	// Skip Duplicate Recipient So Nobody Receive The Same Message Twice
+	var recipients []pkgWhatsApp.BulkRecipientInput
+	recipientsExist := make(map[string]bool)
+
+	for i, record := range records {
+		msisdn := pkgWhatsApp.WhatsAppDecomposeJID(strings.TrimSpace(record["msisdn"]))
+		if len(msisdn) == 0 {
+			return nil, errors.New("Missing MSISDN on Recipient " + strconv.Itoa(i+1))
+		}
+
+		if recipientsExist[msisdn] {
+			continue
+		}
+
+		recipientsExist[msisdn] = true
+		recipients = append(recipients, pkgWhatsApp.BulkRecipientInput{
+			MSISDN: msisdn,
+			Vars:   record,
+		})
+	}
+
+	return recipients, nil
+}
+
+// GetBulk
+// @Summary     Get Bulk Message Progress
+// @Description Get Bulk Message State and Number of Recipients on Every State
+// @Tags        WhatsApp Send Message
+// @Produce     json
+// @Param       id        path      int     true  "Bulk Message ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/bulk/{id} [get]
+func GetBulk(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Bulk Message ID to Integer")
+	}
+
+	bulk, err := pkgWhatsApp.WhatsAppBulkGet(jid, id)
+	if errors.Is(err, pkgWhatsApp.ErrBulkNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Bulk Message", bulk)
+}
+
+// GetBulkRecipients
+// @Summary     Get Bulk Message Recipients Result
+// @Description Get Bulk Message Result for Every Recipient Ordered as Submitted
+// @Tags        WhatsApp Send Message
+// @Produce     json
+// @Param       id        path      int     true   "Bulk Message ID"
+// @Param       state     query     string  false  "Recipient State"  Enums(pending, sending, sent, queued, not_registered, failed)
+// @Param       after     query     int     false  "Get Recipients After Cursor, Use Next Cursor From Previous Page"
+// @Param       limit     query     int     false  "Maximum Number of Recipients"  default(50)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/bulk/{id}/recipients [get]
+func GetBulkRecipients(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Bulk Message ID to Integer")
+	}
+
+	var reqBulkRecipients typWhatsApp.RequestBulkRecipients
+	reqBulkRecipients.State = strings.TrimSpace(c.QueryParam("state"))
+
+	reqAfter := strings.TrimSpace(c.QueryParam("after"))
+	if len(reqAfter) > 0 {
+		reqBulkRecipients.After, err = strconv.ParseInt(reqAfter, 10, 64)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding After Cursor to Integer")
+		}
+	}
+
+	reqLimit := strings.TrimSpace(c.QueryParam("limit"))
+	if len(reqLimit) > 0 {
+		reqBulkRecipients.Limit, err = strconv.Atoi(reqLimit)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Limit to Integer")
+		}
+	}
+
+	recipientPage, err := pkgWhatsApp.WhatsAppBulkRecipients(jid, id, pkgWhatsApp.BulkRecipientQuery{
+		State: reqBulkRecipients.State,
+		After: reqBulkRecipients.After,
+		Limit: reqBulkRecipients.Limit,
+	})
+	if errors.Is(err, pkgWhatsApp.ErrBulkNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Bulk Message Recipients", recipientPage)
+}
+
+// GetBulkCSV
+// @Summary     Download Bulk Message Recipients Result
+// @Description Download Bulk Message Result for Every Recipient as CSV File
+// @Tags        WhatsApp Send Message
+// @Produce     text/csv
+// @Param       id        path      int     true  "Bulk Message ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/bulk/{id}/csv [get]
+func GetBulkCSV(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Bulk Message ID to Integer")
+	}
+
+	// Read Every Page Before Writing CSV Header
+	// So Error Can Still Be Returned Instead of Truncated CSV File
+	var recipients []pkgWhatsApp.BulkRecipient
+	var after int64
+
+	for {
+		recipientPage, err := pkgWhatsApp.WhatsAppBulkRecipients(jid, id, pkgWhatsApp.BulkRecipientQuery{
+			After: after,
+			Limit: 200,
+		})
+		if errors.Is(err, pkgWhatsApp.ErrBulkNotFound) {
+			return router.ResponseNotFound(c, err.Error())
+		} else if err != nil {
+			return router.ResponseInternalError(c, err.Error())
+		}
+
+		recipients = append(recipients, recipientPage.Recipients...)
+
+		if recipientPage.NextCursor == 0 {
+			break
+		}
+
+		after = recipientPage.NextCursor
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\"bulk-"+strconv.FormatInt(id, 10)+".csv\"")
+	c.Response().WriteHeader(http.StatusOK)
+
+	csvWriter := csv.NewWriter(c.Response())
+	_ = csvWriter.Write([]string{"msisdn", "jid", "state", "msgid", "job_id", "error", "updated_at"})
+
+	for _, recipient := range recipients {
+		jobID := ""
+		if recipient.JobID > 0 {
+			jobID = strconv.FormatInt(recipient.JobID, 10)
+		}
+
+		_ = csvWriter.Write([]string{recipient.MSISDN, recipient.JID, recipient.State, recipient.MsgID, jobID,
+			recipient.Error, recipient.UpdatedAt.Format(time.RFC3339)})
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
+
+// GetPollResults
+// @Summary     Get Poll Results
+// @Description Get Poll Vote Tally by Poll Message ID
+// @Tags        WhatsApp Message
+// @Produce     json
+// @Param       msgid    path  string  true  "Poll Message ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /poll/{msgid}/results [get]
+func GetPollResults(c echo.Context) error {
+	jid := jwtPayload(c).JID
+	msgID := strings.TrimSpace(c.Param("msgid"))
+
+	if len(msgID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Path Value Message ID")
+	}
+
+	pollResult, err := pkgWhatsApp.WhatsAppPollResult(jid, msgID)
+	if errors.Is(err, pkgWhatsApp.ErrWhatsAppPollNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Poll Results", pollResult)
+}
+
+// SendDocument
+// @Summary     Send Document Message
+// @Description Send Document Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       document  formData  file    true  "Document File"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/document [post]
+func SendDocument(c echo.Context) error {
+	return sendMedia(c, "document")
+}
+
+// SendImage
+// @Summary     Send Image Message
+// @Description Send Image Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       caption   formData  string  true  "Caption Image Message"
+// @Param       image     formData  file    true  "Image File"
+// @Param       viewonce  formData  bool    false  "Is View Once"  default(false)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/image [post]
+func SendImage(c echo.Context) error {
+	return sendMedia(c, "image")
+}
+
+// SendAudio
+// @Summary     Send Audio Message
+// @Description Send Audio Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       audio     formData  file    true  "Audio File"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/audio [post]
+func SendAudio(c echo.Context) error {
+	return sendMedia(c, "audio")
+}
+
+// SendVideo
+// @Summary     Send Video Message
+// @Description Send Video Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Send Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       caption   formData  string  true  "Caption Video Message"
+// @Param       video     formData  file    true  "Video File"
+// @Param       viewonce  formData  bool    false  "Is View Once"  default(false)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/video [post]
+func SendVideo(c echo.Context) error {
+	return sendMedia(c, "video")
+}
+
+// SendSticker
+// @Summary     Send Sticker Message
+// @Description Send Sticker Message to Spesific WhatsApp Personal ID or Group ID
 // @Tags        WhatsApp Send Message
 // @Accept      multipart/form-data
 // @Produce     json
-// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
-// @Param       latitude  formData  number  true  "Location Latitude"
-// @Param       longitude formData  number  true  "Location Longitude"
+// @Param       msisdn    formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       sticker   formData  file    true  "Sticker File"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /send/sticker [post]
+func SendSticker(c echo.Context) error {
+	return sendMedia(c, "sticker")
+}
+
+func sendMedia(c echo.Context, mediaType string) error {
+	jid := jwtPayload(c).JID
+
+	msg, err := composeSendMessage(c, mediaType)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return sendMessage(c, jid, "Media", msg)
+}
+
+// composeSendMedia Compose Media Message From Uploaded File
+// Media Type is Also Used as Form File Name and Queue Message Type
+func composeSendMedia(c echo.Context, mediaType string) (*pkgWhatsApp.QueueMessage, error) {
+	var err error
+
+	var reqSendMessage typWhatsApp.RequestSendMessage
+	reqSendMessage.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+
+	// Read Uploaded File Based on Send Media Type
+	fileStream, fileHeader, err := c.Request().FormFile(mediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't Forget to Close The File Stream
+	defer fileStream.Close()
+
+	// Get Uploaded File MIME Type
+	fileType := fileHeader.Header.Get("Content-Type")
+
+	switch mediaType {
+	case "document":
+		reqSendMessage.Message = fileHeader.Filename
+
+	case "image", "video":
+		reqSendMessage.Message = strings.TrimSpace(c.FormValue("caption"))
+
+		// Parse ViewOnce Parameter
+		// If it is Empty Then Default to False
+		isViewOnce := strings.TrimSpace(c.FormValue("viewonce"))
+		if len(isViewOnce) > 0 {
+			reqSendMessage.ViewOnce, err = strconv.ParseBool(isViewOnce)
+			if err != nil {
+				return nil, errors.New("Error While Decoding View Once to Boolean")
+			}
+		}
+	}
+
+	// Convert File Stream in to Bytes
+	// Since WhatsApp Proto for Media is only Accepting Bytes format
+	fileBytes, err := convertFileToBytes(fileStream)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pkgWhatsApp.QueueMessage{
+		Type:       mediaType,
+		RJID:       reqSendMessage.RJID,
+		Text:       reqSendMessage.Message,
+		FileBytes:  fileBytes,
+		FileType:   fileType,
+		IsViewOnce: reqSendMessage.ViewOnce,
+	}, nil
+}
+
+// MessageEdit
+// @Summary     Update Message
+// @Description Update Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn     formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       messageid  formData  string  true  "Message ID"
+// @Param       message    formData  string  true  "Text Message"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /message/edit [post]
+func MessageEdit(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqMessageEdit typWhatsApp.RequestMessage
+	reqMessageEdit.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+	reqMessageEdit.MSGID = strings.TrimSpace(c.FormValue("messageid"))
+	reqMessageEdit.Message = strings.TrimSpace(c.FormValue("message"))
+
+	if len(reqMessageEdit.RJID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value MSISDN")
+	}
+
+	if len(reqMessageEdit.MSGID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Message ID")
+	}
+
+	if len(reqMessageEdit.Message) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Message")
+	}
+
+	var resSendMessage typWhatsApp.ResponseSendMessage
+	resSendMessage.MsgID, err = pkgWhatsApp.WhatsAppMessageEdit(c.Request().Context(), jid, reqMessageEdit.RJID, reqMessageEdit.MSGID, reqMessageEdit.Message)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Update Message", resSendMessage)
+}
+
+// MessageReact
+// @Summary     React Message
+// @Description React Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn     formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       messageid  formData  string  true  "Message ID"
+// @Param       sender     formData  string  false  "Message Sender WhatsApp Personal ID, Default to Own WhatsApp ID"
+// @Param       emoji      formData  string  false  "Reaction Emoji, Empty to Remove Reaction"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /message/react [post]
+func MessageReact(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqMessageReact typWhatsApp.RequestMessage
+	reqMessageReact.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+	reqMessageReact.MSGID = strings.TrimSpace(c.FormValue("messageid"))
+	reqMessageReact.Sender = strings.TrimSpace(c.FormValue("sender"))
+	reqMessageReact.Emoji = strings.TrimSpace(c.FormValue("emoji"))
+
+	if len(reqMessageReact.RJID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value MSISDN")
+	}
+
+	if len(reqMessageReact.MSGID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Message ID")
+	}
+
+	// Empty Emoji Remove Previous Reaction
+	// Otherwise Make Sure Reaction is Exactly One Emoji
+	// Multi Code Point Emoji is Counted as One Grapheme Cluster
+	if len(reqMessageReact.Emoji) > 0 && (uniseg.GraphemeClusterCount(reqMessageReact.Emoji) != 1 || !gomoji.ContainsEmoji(reqMessageReact.Emoji)) {
+		return router.ResponseBadRequest(c, "Form Value Emoji Should be a Single Emoji")
+	}
+
+	var resSendMessage typWhatsApp.ResponseSendMessage
+	resSendMessage.MsgID, err = pkgWhatsApp.WhatsAppMessageReact(c.Request().Context(), jid, reqMessageReact.RJID, reqMessageReact.MSGID, reqMessageReact.Sender, reqMessageReact.Emoji)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully React Message", resSendMessage)
+}
+
+// MessageDelete
+// @Summary     Delete Message
+// @Description Delete Message to Spesific WhatsApp Personal ID or Group ID
+// @Tags        WhatsApp Message
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       msisdn     formData  string  true  "Destination WhatsApp Personal ID or Group ID"
+// @Param       messageid  formData  string  true  "Message ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /message/delete [post]
+func MessageDelete(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqMessageDelete typWhatsApp.RequestMessage
+	reqMessageDelete.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+	reqMessageDelete.MSGID = strings.TrimSpace(c.FormValue("messageid"))
+
+	if len(reqMessageDelete.RJID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value MSISDN")
+	}
+
+	if len(reqMessageDelete.MSGID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Message ID")
+	}
+
+	err = pkgWhatsApp.WhatsAppMessageDelete(c.Request().Context(), jid, reqMessageDelete.RJID, reqMessageDelete.MSGID)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Delete Message")
+}
+
+// GetWebhook
+// @Summary     Get Webhook Configuration
+// @Description Get Webhook Configuration for Incoming WhatsApp Event, Secret is Only Returned When Webhook is Set
+// @Tags        WhatsApp Webhook
+// @Produce     json
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /webhook [get]
+func GetWebhook(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	webhook, err := pkgWebhook.WebhookGet(jid)
+	if err != nil {
+		return router.ResponseNotFound(c, err.Error())
+	}
+
+	// Secret is Only Returned When Webhook is Set
+	webhook.Secret = ""
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Webhook", webhook)
+}
+
+// SetWebhook
+// @Summary     Set Webhook Configuration
+// @Description Set Webhook URL for Incoming WhatsApp Event, Secret Will Be Generated if Empty
+// @Tags        WhatsApp Webhook
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       url       formData  string  true   "Webhook URL"
+// @Param       secret    formData  string  false  "Webhook HMAC-SHA256 Signing Secret"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /webhook [post]
+func SetWebhook(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	var reqWebhook typWhatsApp.RequestWebhook
+	reqWebhook.URL = strings.TrimSpace(c.FormValue("url"))
+	reqWebhook.Secret = strings.TrimSpace(c.FormValue("secret"))
+
+	if len(reqWebhook.URL) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value URL")
+	}
+
+	webhook, err := pkgWebhook.WebhookSet(jid, reqWebhook.URL, reqWebhook.Secret)
+	if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Set Webhook", webhook)
+}
+
+// DeleteWebhook
+// @Summary     Delete Webhook Configuration
+// @Description Delete Webhook Configuration for Incoming WhatsApp Event
+// @Tags        WhatsApp Webhook
+// @Produce     json
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /webhook [delete]
+func DeleteWebhook(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	err := pkgWebhook.WebhookDelete(jid)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Delete Webhook")
+}
+
+// GetWebhookDeadLetters
+// @Summary     Get Webhook Dead-Letters
+// @Description Get Webhook Deliveries That Still Failed After Every Retry
+// @Tags        WhatsApp Webhook
+// @Produce     json
+// @Param       limit     query     int     false  "Maximum Number of Dead-Letters"  default(100)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /webhook/deadletter [get]
+func GetWebhookDeadLetters(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	var err error
+	var limit int
+
+	// Parse Limit Parameter
+	// If it is Empty Then Use Default Limit
+	reqLimit := strings.TrimSpace(c.QueryParam("limit"))
+	if len(reqLimit) > 0 {
+		limit, err = strconv.Atoi(reqLimit)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Limit to Integer")
+		}
+	}
+
+	deadLetters, err := pkgWebhook.WebhookDeadLetters(jid, limit)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Webhook Dead-Letters", deadLetters)
+}
+
+// PurgeWebhookDeadLetters
+// @Summary     Purge Webhook Dead-Letters
+// @Description Remove Every Webhook Dead-Letter
+// @Tags        WhatsApp Webhook
+// @Produce     json
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /webhook/deadletter [delete]
+func PurgeWebhookDeadLetters(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	err := pkgWebhook.WebhookDeadLettersPurge(jid)
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Purge Webhook Dead-Letters")
+}
+
+func serverSentEventsStart(c echo.Context) {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+}
+
+func serverSentEventsWrite(c echo.Context, id string, event string, data interface{}) error {
+	eventData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	res := c.Response()
+	if len(id) > 0 {
+		_, err = fmt.Fprintf(res, "id: %s\n", id)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event, eventData)
+	if err != nil {
+		return err
+	}
+
+	res.Flush()
+
+	return nil
+}
+
+func eventStreamFilter(c echo.Context) pkgStream.StreamFilter {
+	filter := pkgStream.StreamFilter{
+		Types: make(map[string]bool),
+		Chats: make(map[string]bool),
+	}
+
+	for _, eventType := range strings.Split(c.QueryParam("type"), ",") {
+		eventType = strings.TrimSpace(eventType)
+		if len(eventType) > 0 {
+			filter.Types[eventType] = true
+		}
+	}
+
+	for _, chat := range strings.Split(c.QueryParam("chat"), ",") {
+		chat = strings.TrimSpace(chat)
+		if len(chat) > 0 {
+			filter.Chats[pkgWhatsApp.WhatsAppComposeJID(chat).String()] = true
+		}
+	}
+
+	return filter
+}
+
+func eventStreamLastID(c echo.Context) (uint64, error) {
+	// Browser Will Send Last-Event-ID Header When Reconnecting
+	// Other Client Can Use Query Parameter Instead
+	lastEventID := strings.TrimSpace(c.Request().Header.Get("Last-Event-ID"))
+	if len(lastEventID) == 0 {
+		lastEventID = strings.TrimSpace(c.QueryParam("last_event_id"))
+	}
+
+	if len(lastEventID) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(lastEventID, 10, 64)
+}
+
+// EventStream
+// @Summary     Stream WhatsApp Events Using Server-Sent Events
+// @Description Stream Live WhatsApp Events, Reset Event is Sent First When Events After Last Event ID Can Not Be Replayed
+// @Tags        WhatsApp Event
+// @Produce     text/event-stream
+// @Param       type           query     string  false  "Comma Separated Event Type Filter"
+// @Param       chat           query     string  false  "Comma Separated Chat WhatsApp Personal ID or Group ID Filter"
+// @Param       last_event_id  query     int     false  "Resume Stream After Event ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /events/stream [get]
+func EventStream(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	lastEventID, err := eventStreamLastID(c)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Last Event ID to Integer")
+	}
+
+	subscriber := pkgStream.StreamSubscribe(jid, lastEventID, eventStreamFilter(c))
+	defer subscriber.Close()
+
+	res := c.Response()
+	serverSentEventsStart(c)
+
+	// Tell Client That Some Events Can Not Be Replayed
+	if subscriber.Reset != nil {
+		err = serverSentEventsWrite(c, "", pkgStream.StreamEventReset, subscriber.Reset)
+		if err != nil {
+			return nil
+		}
+	}
+
+	heartbeat := time.NewTicker(pkgStream.StreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+
+		case <-heartbeat.C:
+			_, err = res.Write([]byte(": heartbeat\n\n"))
+			if err != nil {
+				return nil
+			}
+
+			res.Flush()
+
+		case streamEvent, isOpen := <-subscriber.Events:
+			if !isOpen {
+				return nil
+			}
+
+			err = serverSentEventsWrite(c, strconv.FormatUint(streamEvent.Seq, 10), streamEvent.Event.Type, streamEvent.Event)
+			if err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+var eventWebSocketUpgrader = websocket.Upgrader{
+	// Authentication is Using JWT Token Instead of Cookie
+	// So Cross Origin Request is Safe to be Allowed
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// EventWebSocket
+// @Summary     Stream WhatsApp Events Using WebSocket
+// @Description Stream Live WhatsApp Events, Token Can Also Be Sent Using Query Parameter Since Browser WebSocket Can Not Set Header
+// @Tags        WhatsApp Event
+// @Param       type           query     string  false  "Comma Separated Event Type Filter"
+// @Param       chat           query     string  false  "Comma Separated Chat WhatsApp Personal ID or Group ID Filter"
+// @Param       last_event_id  query     int     false  "Resume Stream After Event ID"
+// @Param       token          query     string  false  "JWT Token"
+// @Success     101
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /events/ws [get]
+func EventWebSocket(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	lastEventID, err := eventStreamLastID(c)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Last Event ID to Integer")
+	}
+
+	conn, err := eventWebSocketUpgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+
+	subscriber := pkgStream.StreamSubscribe(jid, lastEventID, eventStreamFilter(c))
+	defer subscriber.Close()
+
+	// Connection is Considered Dead When No Pong Received
+	// Within Two Heartbeat Interval
+	conn.SetReadDeadline(time.Now().Add(2 * pkgStream.StreamHeartbeat))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * pkgStream.StreamHeartbeat))
+	})
+
+	// Tell Client That Some Events Can Not Be Replayed
+	if subscriber.Reset != nil {
+		err = conn.WriteJSON(typWhatsApp.ResponseEventStream{
+			Event: subscriber.Reset,
+		})
+		if err != nil {
+			return nil
+		}
+	}
+
+	// Read Incoming Message to Process Control Frame
+	// And Detect Closed Connection
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(pkgStream.StreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return nil
+
+		case <-heartbeat.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(pkgStream.StreamHeartbeat))
+			if err != nil {
+				return nil
+			}
+
+		case streamEvent, isOpen := <-subscriber.Events:
+			if !isOpen {
+				conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "Event Stream is Too Slow"),
+					time.Now().Add(time.Second))
+				return nil
+			}
+
+			conn.SetWriteDeadline(time.Now().Add(pkgStream.StreamHeartbeat))
+
+			err = conn.WriteJSON(typWhatsApp.ResponseEventStream{
+				ID:    streamEvent.Seq,
+				Event: streamEvent.Event,
+			})
+			if err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// GetMessages
+// @Summary     Get Message History
+// @Description Get Sent and Received Message History Ordered From The Newest Message
+// @Tags        WhatsApp Message
+// @Produce     json
+// @Param       chat      query     string  false  "Chat WhatsApp Personal ID or Group ID"
+// @Param       type      query     string  false  "Message Type"  Enums(text, image, video, audio, document, sticker, location, contact, poll, poll_update, reaction)
+// @Param       before    query     int     false  "Get Messages Before Cursor, Use Next Cursor From Previous Page"
+// @Param       limit     query     int     false  "Maximum Number of Messages"  default(50)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /messages [get]
+func GetMessages(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqMessageHistory typWhatsApp.RequestMessageHistory
+	reqMessageHistory.Chat = strings.TrimSpace(c.QueryParam("chat"))
+	reqMessageHistory.Type = strings.TrimSpace(c.QueryParam("type"))
+
+	if len(reqMessageHistory.Chat) > 0 {
+		reqMessageHistory.Chat = pkgWhatsApp.WhatsAppComposeJID(reqMessageHistory.Chat).String()
+	}
+
+	reqBefore := strings.TrimSpace(c.QueryParam("before"))
+	if len(reqBefore) > 0 {
+		reqMessageHistory.Before, err = strconv.ParseInt(reqBefore, 10, 64)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Before Cursor to Integer")
+		}
+	}
+
+	reqLimit := strings.TrimSpace(c.QueryParam("limit"))
+	if len(reqLimit) > 0 {
+		reqMessageHistory.Limit, err = strconv.Atoi(reqLimit)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Limit to Integer")
+		}
+	}
+
+	historyPage, err := pkgWhatsApp.WhatsAppHistoryQuery(jid, pkgWhatsApp.HistoryQuery{
+		Chat:   reqMessageHistory.Chat,
+		Type:   reqMessageHistory.Type,
+		Before: reqMessageHistory.Before,
+		Limit:  reqMessageHistory.Limit,
+	})
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Messages", historyPage)
+}
+
+// GetMessageStatus
+// @Summary     Get Message Delivery Status
+// @Description Get Sent Message Delivery and Read Status, Group Message Will Have Status for Every Participant
+// @Tags        WhatsApp Message
+// @Produce     json
+// @Param       msgid     path      string  true  "Message ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /message/{msgid}/status [get]
+func GetMessageStatus(c echo.Context) error {
+	jid := jwtPayload(c).JID
+	msgID := strings.TrimSpace(c.Param("msgid"))
+
+	if len(msgID) == 0 {
+		return router.ResponseBadRequest(c, "Missing Path Value Message ID")
+	}
+
+	receiptStatus, err := pkgWhatsApp.WhatsAppReceiptStatusGet(jid, msgID)
+	if err != nil {
+		return router.ResponseNotFound(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Message Status", receiptStatus)
+}
+
+// GetQueueJobs
+// @Summary     Get Outbound Queue Jobs
+// @Description Get Outbound Queue Jobs Ordered From The Newest Job
+// @Tags        WhatsApp Queue
+// @Produce     json
+// @Param       state     query     string  false  "Job State"  Enums(queued, sending, sent, failed)
+// @Param       before    query     int     false  "Get Jobs Before Cursor, Use Next Cursor From Previous Page"
+// @Param       limit     query     int     false  "Maximum Number of Jobs"  default(50)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /queue [get]
+func GetQueueJobs(c echo.Context) error {
+	var err error
+	jid := jwtPayload(c).JID
+
+	var reqQueueJobs typWhatsApp.RequestQueueJobs
+	reqQueueJobs.State = strings.TrimSpace(c.QueryParam("state"))
+
+	reqBefore := strings.TrimSpace(c.QueryParam("before"))
+	if len(reqBefore) > 0 {
+		reqQueueJobs.Before, err = strconv.ParseInt(reqBefore, 10, 64)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Before Cursor to Integer")
+		}
+	}
+
+	reqLimit := strings.TrimSpace(c.QueryParam("limit"))
+	if len(reqLimit) > 0 {
+		reqQueueJobs.Limit, err = strconv.Atoi(reqLimit)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Limit to Integer")
+		}
+	}
+
+	queuePage, err := pkgWhatsApp.WhatsAppQueueList(jid, pkgWhatsApp.QueueQuery{
+		State:  reqQueueJobs.State,
+		Before: reqQueueJobs.Before,
+		Limit:  reqQueueJobs.Limit,
+	})
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Queue Jobs", queuePage)
+}
+
+// GetQueueJob
+// @Summary     Get Outbound Queue Job
+// @Description Get Outbound Queue Job State and Sent Message ID
+// @Tags        WhatsApp Queue
+// @Produce     json
+// @Param       id        path      int     true  "Queue Job ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /queue/{id} [get]
+func GetQueueJob(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	jobID, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Queue Job ID to Integer")
+	}
+
+	queueJob, err := pkgWhatsApp.WhatsAppQueueGet(jid, jobID)
+	if err != nil {
+		return router.ResponseNotFound(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Queue Job", queueJob)
+}
+
+// GetSchedules
+// @Summary     Get Message Schedules
+// @Description Get Message Schedules Ordered From The Newest Schedule
+// @Tags        WhatsApp Schedule
+// @Produce     json
+// @Param       state     query     string  false  "Schedule State"  Enums(active, paused, completed)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /schedule [get]
+func GetSchedules(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	schedules, err := pkgWhatsApp.WhatsAppScheduleList(jid, strings.TrimSpace(c.QueryParam("state")))
+	if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Schedules", schedules)
+}
+
+// CreateSchedule
+// @Summary     Create Message Schedule
+// @Description Schedule Any Supported Message Type to be Sent at Absolute Time or Repeatedly on Cron Expression
+// @Description Message Form Values Are The Same as The Send Message Endpoint of Selected Type
+// @Tags        WhatsApp Schedule
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       name      formData  string  false  "Schedule Name"
+// @Param       type      formData  string  true   "Message Type"  Enums(text, location, link, poll, contact, document, image, audio, video, sticker)
+// @Param       msisdn    formData  string  true   "Destination WhatsApp Personal ID or Group ID"
+// @Param       run_at    formData  string  false  "Absolute Time in RFC3339 or YYYY-MM-DD HH:MM:SS Format"
+// @Param       cron      formData  string  false  "Standard Cron Expression (Minute Hour Day Month Weekday) or Descriptor Like @daily, Minimum Interval is One Minute"
+// @Param       timezone  formData  string  false  "IANA Timezone Used by Time Without Offset and Cron Expression"  default(UTC)
 // @Success     200
 // @Security    BearerAuth
-// @Router      /send/location [post]
-func SendLocation(c echo.Context) error {
+// @Security    APIKeyAuth
+// @Router      /schedule [post]
+func CreateSchedule(c echo.Context) error {
 	var err error
 	jid := jwtPayload(c).JID
 
-	var reqSendLocation typWhatsApp.RequestSendLocation
-	reqSendLocation.RJID = strings.TrimSpace(c.FormValue("msisdn"))
+	var reqSchedule typWhatsApp.RequestSchedule
+	reqSchedule.Name = strings.TrimSpace(c.FormValue("name"))
+	reqSchedule.Type = strings.TrimSpace(c.FormValue("type"))
+	reqSchedule.RunAt = strings.TrimSpace(c.FormValue("run_at"))
+	reqSchedule.Cron = strings.TrimSpace(c.FormValue("cron"))
+	reqSchedule.Timezone = strings.TrimSpace(c.FormValue("timezone"))
 
-	reqSendLocation.Latitude, err = strconv.ParseFloat(strings.TrimSpace(c.FormValue("latitude")), 64)
+	if len(reqSchedule.Type) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Type")
+	}
+
+	if len(reqSchedule.RunAt) == 0 && len(reqSchedule.Cron) == 0 {
+		return router.ResponseBadRequest(c, "Missing Form Value Run At or Cron")
+	}
+
+	if len(reqSchedule.RunAt) > 0 && len(reqSchedule.Cron) > 0 {
+		return router.ResponseBadRequest(c, "Form Value Run At and Cron Can Not be Used Together")
+	}
+
+	if len(reqSchedule.Timezone) == 0 {
+		reqSchedule.Timezone = "UTC"
+	}
+
+	var runAt *time.Time
+	if len(reqSchedule.RunAt) > 0 {
+		scheduleRunAt, err := pkgWhatsApp.WhatsAppScheduleParseTime(reqSchedule.RunAt, reqSchedule.Timezone)
+		if err != nil {
+			return router.ResponseBadRequest(c, err.Error())
+		}
+
+		runAt = &scheduleRunAt
+	}
+
+	msg, err := composeSendMessage(c, reqSchedule.Type)
 	if err != nil {
-		return router.ResponseInternalError(c, "Error While Decoding Latitude to Float64")
+		return router.ResponseBadRequest(c, err.Error())
 	}
 
-	reqSendLocation.Longitude, err = strconv.ParseFloat(strings.TrimSpace(c.FormValue("longitude")), 64)
+	schedule, err := pkgWhatsApp.WhatsAppScheduleCreate(jid, reqSchedule.Name, msg, reqSchedule.Cron, runAt, reqSchedule.Timezone)
 	if err != nil {
-		return router.ResponseInternalError(c, "Error While Decoding Longitude to Float64")
+		return router.ResponseBadRequest(c, err.Error())
 	}
 
-	if len(reqSendLocation.RJID) == 0 {
-		return router.ResponseBadRequest(c, "Missing Form Value MSISDN")
+	return router.ResponseSuccessWithData(c, "Successfully Create Schedule", schedule)
+}
+
+// GetSchedule
+// @Summary     Get Message Schedule
+// @Description Get Message Schedule and Its Next Run Time
+// @Tags        WhatsApp Schedule
+// @Produce     json
+// @Param       id        path      int     true  "Schedule ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /schedule/{id} [get]
+func GetSchedule(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Schedule ID to Integer")
 	}
 
-	var resSendMessage typWhatsApp.ResponseSendMessage
-	resSendMessage.MsgID, err = pkgWhatsApp.WhatsAppSendLocation(c.Request().Context(), jid, reqSendLocation.RJID, reqSendLocation.Latitude, reqSendLocation.Longitude)
+	schedule, err := pkgWhatsApp.WhatsAppScheduleGet(jid, id)
+	if errors.Is(err, pkgWhatsApp.ErrScheduleNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Schedule", schedule)
+}
+
+// PauseSchedule
+// @Summary     Pause Message Schedule
+// @Description Pause Active Message Schedule
+// @Tags        WhatsApp Schedule
+// @Produce     json
+// @Param       id        path      int     true  "Schedule ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /schedule/{id}/pause [post]
+func PauseSchedule(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Schedule ID to Integer")
+	}
+
+	schedule, err := pkgWhatsApp.WhatsAppSchedulePause(jid, id)
+	if errors.Is(err, pkgWhatsApp.ErrScheduleNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Pause Schedule", schedule)
+}
+
+// ResumeSchedule
+// @Summary     Resume Message Schedule
+// @Description Resume Paused Message Schedule, Missed Absolute Time Schedule is Sent Immediately
+// @Tags        WhatsApp Schedule
+// @Produce     json
+// @Param       id        path      int     true  "Schedule ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /schedule/{id}/resume [post]
+func ResumeSchedule(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Schedule ID to Integer")
+	}
+
+	schedule, err := pkgWhatsApp.WhatsAppScheduleResume(jid, id)
+	if errors.Is(err, pkgWhatsApp.ErrScheduleNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseBadRequest(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Resume Schedule", schedule)
+}
+
+// DeleteSchedule
+// @Summary     Delete Message Schedule
+// @Description Delete Message Schedule and Its Execution History
+// @Tags        WhatsApp Schedule
+// @Produce     json
+// @Param       id        path      int     true  "Schedule ID"
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /schedule/{id} [delete]
+func DeleteSchedule(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Schedule ID to Integer")
+	}
+
+	err = pkgWhatsApp.WhatsAppScheduleDelete(jid, id)
+	if errors.Is(err, pkgWhatsApp.ErrScheduleNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
+		return router.ResponseInternalError(c, err.Error())
+	}
+
+	return router.ResponseSuccess(c, "Successfully Delete Schedule")
+}
+
+// GetScheduleHistory
+// @Summary     Get Message Schedule History
+// @Description Get Message Schedule Executions and Their Sent Message ID Ordered From The Newest Execution
+// @Tags        WhatsApp Schedule
+// @Produce     json
+// @Param       id        path      int     true   "Schedule ID"
+// @Param       before    query     int     false  "Get Executions Before Cursor, Use Next Cursor From Previous Page"
+// @Param       limit     query     int     false  "Maximum Number of Executions"  default(50)
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /schedule/{id}/history [get]
+func GetScheduleHistory(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
 	if err != nil {
+		return router.ResponseBadRequest(c, "Error While Decoding Schedule ID to Integer")
+	}
+
+	var reqScheduleHistory typWhatsApp.RequestScheduleHistory
+
+	reqBefore := strings.TrimSpace(c.QueryParam("before"))
+	if len(reqBefore) > 0 {
+		reqScheduleHistory.Before, err = strconv.ParseInt(reqBefore, 10, 64)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Before Cursor to Integer")
+		}
+	}
+
+	reqLimit := strings.TrimSpace(c.QueryParam("limit"))
+	if len(reqLimit) > 0 {
+		reqScheduleHistory.Limit, err = strconv.Atoi(reqLimit)
+		if err != nil {
+			return router.ResponseBadRequest(c, "Error While Decoding Limit to Integer")
+		}
+	}
+
+	schedulePage, err := pkgWhatsApp.WhatsAppScheduleRuns(jid, id, pkgWhatsApp.ScheduleRunQuery{
+		Before: reqScheduleHistory.Before,
+		Limit:  reqScheduleHistory.Limit,
+	})
+	if errors.Is(err, pkgWhatsApp.ErrScheduleNotFound) {
+		return router.ResponseNotFound(c, err.Error())
+	} else if err != nil {
 		return router.ResponseInternalError(c, err.Error())
 	}
 
-	return router.ResponseSuccessWithData(c, "Successfully Send Location Message", resSendMessage)
-}
\ No newline at end of file
+	return router.ResponseSuccessWithData(c, "Successfully Get Schedule History", schedulePage)
+}
+
+// GetSession
+// @Summary     Get Session Status
+// @Description Get WhatsApp Client Connection State and Device Information
+// @Tags        WhatsApp Session
+// @Produce     json
+// @Success     200
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Router      /session [get]
+func GetSession(c echo.Context) error {
+	jid := jwtPayload(c).JID
+
+	sessionStatus, err := pkgWhatsApp.WhatsAppSession.Status(jid)
+	if err != nil {
+		return router.ResponseNotFound(c, err.Error())
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Session", sessionStatus)
+}
+
+// GetSessions
+// @Summary     Get All Session Status
+// @Description Get Connection State and Device Information of Every WhatsApp Client
+// @Tags        WhatsApp Session
+// @Produce     json
+// @Success     200
+// @Security    BasicAuth
+// @Router      /sessions [get]
+func GetSessions(c echo.Context) error {
+	sessionStatuses := []*pkgWhatsApp.SessionStatus{}
+
+	for _, jid := range pkgWhatsApp.WhatsAppSession.List() {
+		sessionStatus, err := pkgWhatsApp.WhatsAppSession.Status(jid)
+		if err != nil {
+			// WhatsApp Client is Removed While Listing
+			continue
+		}
+
+		sessionStatuses = append(sessionStatuses, sessionStatus)
+	}
+
+	return router.ResponseSuccessWithData(c, "Successfully Get Sessions", sessionStatuses)
+}
@@ -0,0 +1,320 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/router"
+)
+
+const (
+	AuthScopeSend         = "send"
+	AuthScopeGroupRead    = "group:read"
+	AuthScopeGroupWrite   = "group:write"
+	AuthScopeSessionAdmin = "session:admin"
+	AuthScopeEventsRead   = "events:read"
+
+	AuthAPIKeyHeader = "X-API-Key"
+
+	authAPIKeyPrefix     = "wak_"
+	authAPIKeyContextKey = "apikey"
+)
+
+var AuthScopes = []string{
+	AuthScopeSend,
+	AuthScopeGroupRead,
+	AuthScopeGroupWrite,
+	AuthScopeSessionAdmin,
+	AuthScopeEventsRead,
+}
+
+type AuthAPIKey struct {
+	ID        string     `json:"id"`
+	JID       string     `json:"jid"`
+	Username  string     `json:"username"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+var ErrAuthAPIKeyNotFound = errors.New("API Key is Not Found")
+
+var authAPIKeyMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE auth_apikey (
+				id         TEXT PRIMARY KEY,
+				jid        TEXT NOT NULL,
+				username   TEXT NOT NULL,
+				name       TEXT NOT NULL,
+				scopes     TEXT NOT NULL,
+				key_hash   TEXT NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				revoked_at TIMESTAMP
+			)`,
+			`CREATE INDEX auth_apikey_jid_idx ON auth_apikey (jid)`,
+			`CREATE INDEX auth_apikey_username_idx ON auth_apikey (username)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE auth_apikey (
+				id         TEXT PRIMARY KEY,
+				jid        TEXT NOT NULL,
+				username   TEXT NOT NULL,
+				name       TEXT NOT NULL,
+				scopes     TEXT NOT NULL,
+				key_hash   TEXT NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL,
+				revoked_at TIMESTAMPTZ
+			)`,
+			`CREATE INDEX auth_apikey_jid_idx ON auth_apikey (jid)`,
+			`CREATE INDEX auth_apikey_username_idx ON auth_apikey (username)`,
+		},
+	},
+}
+
+func init() {
+	datastore.Register("auth_apikey", authAPIKeyMigrations)
+}
+
+// API Key and Refresh Token Have High Entropy Random Secret
+// So Single SHA-256 Hash is Enough and Keep Verification Fast
+func authHashToken(key string) string {
+	keyHash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(keyHash[:])
+}
+
+// AuthScopesParse Parse Comma Separated Scopes and Validate Every Scope
+func AuthScopesParse(scopes string) ([]string, error) {
+	var result []string
+	isExist := make(map[string]bool)
+
+	for _, scope := range strings.Split(scopes, ",") {
+		scope = strings.TrimSpace(scope)
+		if len(scope) == 0 || isExist[scope] {
+			continue
+		}
+
+		isValid := false
+		for _, validScope := range AuthScopes {
+			if scope == validScope {
+				isValid = true
+				break
+			}
+		}
+
+		if !isValid {
+			return nil, errors.New("Invalid Scope " + scope)
+		}
+
+		isExist[scope] = true
+		result = append(result, scope)
+	}
+
+	if len(result) == 0 {
+		return nil, errors.New("API Key Should Have at Least One Scope")
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// AuthAPIKeyCreate Create New API Key Bound to JID With Given Scopes
+// Username is The User Who Create The API Key, So The API Key Can Be Revoked Along With The User
+// The Plain API Key is Only Returned Once and Never Stored
+func AuthAPIKeyCreate(jid string, username string, name string, scopes []string) (*AuthAPIKey, string, error) {
+	idBytes := make([]byte, 8)
+	secretBytes := make([]byte, 32)
+
+	_, err := rand.Read(idBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = rand.Read(secretBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey := &AuthAPIKey{
+		ID:        hex.EncodeToString(idBytes),
+		JID:       jid,
+		Username:  username,
+		Name:      strings.TrimSpace(name),
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	key := authAPIKeyPrefix + apiKey.ID + "." + base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	_, err = datastore.DB.Exec("INSERT INTO auth_apikey (id, jid, username, name, scopes, key_hash, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		apiKey.ID, apiKey.JID, apiKey.Username, apiKey.Name, strings.Join(apiKey.Scopes, ","), authHashToken(key), apiKey.CreatedAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return apiKey, key, nil
+}
+
+func authAPIKeyScan(row interface{ Scan(...interface{}) error }, keyHash *string) (*AuthAPIKey, error) {
+	var apiKey AuthAPIKey
+	var scopes string
+	var revokedAt sql.NullTime
+
+	err := row.Scan(&apiKey.ID, &apiKey.JID, &apiKey.Username, &apiKey.Name, &scopes, keyHash, &apiKey.CreatedAt, &revokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey.Scopes = strings.Split(scopes, ",")
+	if revokedAt.Valid {
+		apiKey.RevokedAt = &revokedAt.Time
+	}
+
+	return &apiKey, nil
+}
+
+// AuthAPIKeyList Get Every API Key Bound to JID Ordered From The Newest
+func AuthAPIKeyList(jid string) ([]AuthAPIKey, error) {
+	rows, err := datastore.DB.Query(`SELECT id, jid, username, name, scopes, key_hash, created_at, revoked_at
+		FROM auth_apikey WHERE jid=$1 ORDER BY created_at DESC`, jid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	apiKeys := []AuthAPIKey{}
+	for rows.Next() {
+		var keyHash string
+
+		apiKey, err := authAPIKeyScan(rows, &keyHash)
+		if err != nil {
+			return nil, err
+		}
+
+		apiKeys = append(apiKeys, *apiKey)
+	}
+
+	return apiKeys, rows.Err()
+}
+
+// AuthAPIKeyRevoke Revoke API Key Bound to JID
+func AuthAPIKeyRevoke(jid string, id string) error {
+	res, err := datastore.DB.Exec("UPDATE auth_apikey SET revoked_at=$1 WHERE jid=$2 AND id=$3 AND revoked_at IS NULL",
+		time.Now().UTC(), jid, id)
+	if err != nil {
+		return err
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return ErrAuthAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// AuthAPIKeyRevokeUser Revoke Every API Key Created by User
+func AuthAPIKeyRevokeUser(username string) error {
+	_, err := datastore.DB.Exec("UPDATE auth_apikey SET revoked_at=$1 WHERE username=$2 AND revoked_at IS NULL",
+		time.Now().UTC(), username)
+
+	return err
+}
+
+// AuthAPIKeyVerify Verify Plain API Key and Make Sure It is Not Revoked
+func AuthAPIKeyVerify(key string) (*AuthAPIKey, error) {
+	// API Key Format is Prefix + ID + "." + Secret
+	keyParts := strings.SplitN(strings.TrimPrefix(key, authAPIKeyPrefix), ".", 2)
+	if !strings.HasPrefix(key, authAPIKeyPrefix) || len(keyParts) != 2 {
+		return nil, errors.New("Invalid API Key")
+	}
+
+	var keyHash string
+
+	apiKey, err := authAPIKeyScan(datastore.DB.QueryRow(`SELECT id, jid, username, name, scopes, key_hash, created_at, revoked_at
+		FROM auth_apikey WHERE id=$1`, keyParts[0]), &keyHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("Invalid API Key")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(keyHash), []byte(authHashToken(key))) != 1 {
+		return nil, errors.New("Invalid API Key")
+	}
+
+	if apiKey.RevokedAt != nil {
+		return nil, errors.New("API Key is Revoked")
+	}
+
+	return apiKey, nil
+}
+
+// HasScope Check if API Key Has Given Scope
+func (apiKey *AuthAPIKey) HasScope(scope string) bool {
+	for _, apiKeyScope := range apiKey.Scopes {
+		if apiKeyScope == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// APIKeyAuth Function as Midleware for API Key Authorization
+// Request Without API Key Header is Passed to The Next Authorization Middleware
+func APIKeyAuth() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := strings.TrimSpace(c.Request().Header.Get(AuthAPIKeyHeader))
+			if len(key) == 0 {
+				return next(c)
+			}
+
+			apiKey, err := AuthAPIKeyVerify(key)
+			if err != nil {
+				return router.ResponseUnauthorized(c, err.Error())
+			}
+
+			c.Set(authAPIKeyContextKey, apiKey)
+
+			// Call Next Handler Function With Current Request
+			return next(c)
+		}
+	}
+}
+
+// RequireScope Function as Midleware to Make Sure API Key Has Given Scope
+// Request Authorized Using JWT Token Has Full Access to Its JID
+func RequireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			apiKey := APIKeyGet(c)
+			if apiKey != nil && !apiKey.HasScope(scope) {
+				return router.ResponseForbidden(c, "Missing Required Scope "+scope)
+			}
+
+			// Call Next Handler Function With Current Request
+			return next(c)
+		}
+	}
+}
+
+// APIKeyGet Get Authorized API Key From Request Context
+// Return Nil When Request is Not Authorized Using API Key
+func APIKeyGet(c echo.Context) *AuthAPIKey {
+	apiKey, _ := c.Get(authAPIKeyContextKey).(*AuthAPIKey)
+	return apiKey
+}
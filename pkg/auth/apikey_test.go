@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestAuthAPIKeyRevokeUser(t *testing.T) {
+	_, aliceKey, err := AuthAPIKeyCreate("6281", "alice", "alice", []string{AuthScopeSend})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, bobKey, err := AuthAPIKeyCreate("6281", "bob", "bob", []string{AuthScopeSend})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiKey, err := AuthAPIKeyVerify(aliceKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if apiKey.Username != "alice" {
+		t.Errorf("Username = %s, want alice", apiKey.Username)
+	}
+
+	err = AuthAPIKeyRevokeUser("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = AuthAPIKeyVerify(aliceKey); err == nil {
+		t.Error("API key is still valid after its user is revoked")
+	}
+
+	if _, err = AuthAPIKeyVerify(bobKey); err != nil {
+		t.Errorf("API key of other user is revoked: %v", err)
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	handler := RequireScope(AuthScopeSend)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	tests := map[string]struct {
+		apiKey *AuthAPIKey
+		want   int
+	}{
+		"jwt":           {nil, http.StatusOK},
+		"has scope":     {&AuthAPIKey{Scopes: []string{AuthScopeGroupRead, AuthScopeSend}}, http.StatusOK},
+		"missing scope": {&AuthAPIKey{Scopes: []string{AuthScopeGroupRead}}, http.StatusForbidden},
+	}
+
+	for name, test := range tests {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		if test.apiKey != nil {
+			c.Set(authAPIKeyContextKey, test.apiKey)
+		}
+
+		if err := handler(c); err != nil {
+			t.Fatal(err)
+		}
+
+		if rec.Code != test.want {
+			t.Errorf("%s: status = %d, want %d", name, rec.Code, test.want)
+		}
+	}
+}
@@ -2,18 +2,42 @@ package auth
 
 import (
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
 )
 
 var AuthBasicUsername string
 var AuthBasicPassword string
 
 var AuthJWTSecret string
-var AuthJWTExpiredHour int
+var AuthJWTExpiredMinute int
+var AuthJWTRefreshExpiredHour int
 
 func init() {
+	var err error
+
 	AuthBasicUsername, _ = env.GetEnvString("AUTH_BASIC_USERNAME")
 	AuthBasicPassword, _ = env.GetEnvString("AUTH_BASIC_PASSWORD")
 
+	if len(AuthBasicUsername) == 0 && len(AuthBasicPassword) > 0 {
+		log.Print(nil).Warn("Administrator Basic Auth is Disabled Because AUTH_BASIC_USERNAME is Not Set")
+	}
+
 	AuthJWTSecret, _ = env.GetEnvString("AUTH_JWT_SECRET")
-	AuthJWTExpiredHour, _ = env.GetEnvInt("AUTH_JWT_EXPIRED_HOUR")
+
+	// Access Token Should Always Expire
+	// Legacy Expired Hour Configuration is Still Used When It is Set
+	AuthJWTExpiredMinute, err = env.GetEnvInt("AUTH_JWT_EXPIRED_MINUTE")
+	if err != nil || AuthJWTExpiredMinute <= 0 {
+		authJWTExpiredHour, err := env.GetEnvInt("AUTH_JWT_EXPIRED_HOUR")
+		if err != nil || authJWTExpiredHour <= 0 {
+			AuthJWTExpiredMinute = 15
+		} else {
+			AuthJWTExpiredMinute = authJWTExpiredHour * 60
+		}
+	}
+
+	AuthJWTRefreshExpiredHour, err = env.GetEnvInt("AUTH_JWT_REFRESH_EXPIRED_HOUR")
+	if err != nil || AuthJWTRefreshExpiredHour <= 0 {
+		AuthJWTRefreshExpiredHour = 720
+	}
 }
@@ -1,7 +1,10 @@
 package auth
 
 import (
+	"bytes"
+	"crypto/subtle"
 	"encoding/base64"
+	"encoding/json"
 	"io/ioutil"
 	"strings"
 
@@ -10,47 +13,107 @@ import (
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/router"
 )
 
+type BasicAuthInfo struct {
+	Username string   `json:"username"`
+	IsAdmin  bool     `json:"is_admin"`
+	JIDs     []string `json:"jids"`
+}
+
+// basicAuthVerify Verify Basic Authorization Credentials
+// Credentials From Environment Variables Are Treated as Administrator
+// Otherwise Credentials Will Be Checked Against User Credential Store
+func basicAuthVerify(c echo.Context) (*BasicAuthInfo, error) {
+	// Parse HTTP Header Authorization
+	authHeader := strings.SplitN(c.Request().Header.Get("Authorization"), " ", 2)
+
+	// Check HTTP Header Authorization Section
+	// Authorization Section Length Should Be 2
+	// The First Authorization Section Should Be "Basic"
+	if len(authHeader) != 2 || authHeader[0] != "Basic" {
+		return nil, router.ResponseAuthenticate(c)
+	}
+
+	// The Second Authorization Section Should Be The Credentials Payload
+	// But We Should Decode it First From Base64 Encoding
+	authPayload, err := base64.StdEncoding.DecodeString(authHeader[1])
+	if err != nil {
+		return nil, router.ResponseBadRequest(c, "")
+	}
+
+	// Split Decoded Authorization Payload Into Username and Password Credentials
+	authCredentials := strings.SplitN(string(authPayload), ":", 2)
+
+	// Check Credentials Section
+	// It Should Have 2 Section, Username and Password
+	if len(authCredentials) != 2 {
+		return nil, router.ResponseBadRequest(c, "")
+	}
+
+	// Validate Administrator Credentials
+	// Administrator Login is Refused When Basic Auth Username is Not Set
+	isAdminUsername := len(AuthBasicUsername) > 0 &&
+		subtle.ConstantTimeCompare([]byte(authCredentials[0]), []byte(AuthBasicUsername)) == 1
+	isAdminPassword := len(AuthBasicPassword) > 0 &&
+		subtle.ConstantTimeCompare([]byte(authCredentials[1]), []byte(AuthBasicPassword)) == 1
+
+	if isAdminUsername && isAdminPassword {
+		return &BasicAuthInfo{
+			Username: authCredentials[0],
+			IsAdmin:  true,
+			JIDs:     []string{},
+		}, nil
+	}
+
+	// Validate User Credentials
+	user, err := AuthUserVerify(authCredentials[0], authCredentials[1])
+	if err != nil {
+		return nil, router.ResponseUnauthorized(c, err.Error())
+	}
+
+	return &BasicAuthInfo{
+		Username: user.Username,
+		JIDs:     user.JIDs,
+	}, nil
+}
+
 // BasicAuth Function as Midleware for Basic Authorization
 func BasicAuth() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Parse HTTP Header Authorization
-			authHeader := strings.SplitN(c.Request().Header.Get("Authorization"), " ", 2)
-
-			// Check HTTP Header Authorization Section
-			// Authorization Section Length Should Be 2
-			// The First Authorization Section Should Be "Basic"
-			if len(authHeader) != 2 || authHeader[0] != "Basic" {
-				return router.ResponseAuthenticate(c)
+			authInfo, err := basicAuthVerify(c)
+			if authInfo == nil {
+				return err
 			}
 
-			// The Second Authorization Section Should Be The Credentials Payload
-			// But We Should Decode it First From Base64 Encoding
-			authPayload, err := base64.StdEncoding.DecodeString(authHeader[1])
+			// Make Credentials to JSON Format
+			authInformation, err := json.Marshal(authInfo)
 			if err != nil {
 				return router.ResponseInternalError(c, "")
 			}
 
-			// Split Decoded Authorization Payload Into Username and Password Credentials
-			authCredentials := strings.SplitN(string(authPayload), ":", 2)
+			// Rewrite Body Content With Credentials in JSON Format
+			c.Request().Header.Set("Content-Type", "application/json")
+			c.Request().Body = ioutil.NopCloser(bytes.NewReader(authInformation))
 
-			// Check Credentials Section
-			// It Should Have 2 Section, Username and Password
-			if len(authCredentials) != 2 {
-				return router.ResponseBadRequest(c, "")
-			}
+			// Call Next Handler Function With Current Request
+			return next(c)
+		}
+	}
+}
 
-			// Validate Authentication Password
-			if authCredentials[1] != AuthBasicPassword {
-				return router.ResponseBadRequest(c, "Invalid Authentication")
+// BasicAuthAdmin Function as Midleware for Administrator Basic Authorization
+// Request Body is Not Rewritten So Administrator Endpoint Can Read Its Own Payload
+func BasicAuthAdmin() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authInfo, err := basicAuthVerify(c)
+			if authInfo == nil {
+				return err
 			}
 
-			// Make Credentials to JSON Format
-			authInformation := `{"username": "` + authCredentials[0] + `"}`
-
-			// Rewrite Body Content With Credentials in JSON Format
-			c.Request().Header.Set("Content-Type", "application/json")
-			c.Request().Body = ioutil.NopCloser(strings.NewReader(authInformation))
+			if !authInfo.IsAdmin {
+				return router.ResponseForbidden(c, "Administrator Credentials is Required")
+			}
 
 			// Call Next Handler Function With Current Request
 			return next(c)
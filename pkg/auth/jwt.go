@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+)
+
+type AuthJWTKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.PrivateKey
+	PublicKey  crypto.PublicKey
+}
+
+type AuthJWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
+
+type AuthJWKSet struct {
+	Keys []AuthJWK `json:"keys"`
+}
+
+var AuthJWTKeyDir string
+var AuthJWTKeyID string
+
+var authJWTKeys = struct {
+	sync.RWMutex
+	signing *AuthJWTKey
+	data    map[string]*AuthJWTKey
+}{
+	data: make(map[string]*AuthJWTKey),
+}
+
+func init() {
+	AuthJWTKeyDir, _ = env.GetEnvString("AUTH_JWT_KEY_DIR")
+	AuthJWTKeyID, _ = env.GetEnvString("AUTH_JWT_KEY_ID")
+
+	err := AuthJWTKeysLoad()
+	if err != nil {
+		log.Print(nil).Fatal("Error Load JWT Signing Keys: " + err.Error())
+	}
+}
+
+func authJWTKeyParse(id string, pemBytes []byte) (*AuthJWTKey, error) {
+	pemBlock, _ := pem.Decode(pemBytes)
+	if pemBlock == nil {
+		return nil, errors.New("Key is Not PEM Encoded")
+	}
+
+	key := &AuthJWTKey{
+		ID: id,
+	}
+
+	// Private Key Can Be Used for Signing and Verifying
+	// Public Key Can Only Be Used for Verifying Token Signed by Retired Key
+	if privateKey, err := x509.ParsePKCS8PrivateKey(pemBlock.Bytes); err == nil {
+		key.PrivateKey = privateKey
+	} else if privateKey, err := x509.ParsePKCS1PrivateKey(pemBlock.Bytes); err == nil {
+		key.PrivateKey = privateKey
+	} else if publicKey, err := x509.ParsePKIXPublicKey(pemBlock.Bytes); err == nil {
+		key.PublicKey = publicKey
+	} else if publicKey, err := x509.ParsePKCS1PublicKey(pemBlock.Bytes); err == nil {
+		key.PublicKey = publicKey
+	} else {
+		return nil, errors.New("Key Format is Not Supported")
+	}
+
+	switch privateKey := key.PrivateKey.(type) {
+	case *rsa.PrivateKey:
+		key.PublicKey = &privateKey.PublicKey
+	case ed25519.PrivateKey:
+		key.PublicKey = privateKey.Public()
+	case nil:
+	default:
+		return nil, errors.New("Key Type is Not Supported, Only RSA and Ed25519 Are Supported")
+	}
+
+	switch key.PublicKey.(type) {
+	case *rsa.PublicKey:
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.New("Key Type is Not Supported, Only RSA and Ed25519 Are Supported")
+	}
+
+	return key, nil
+}
+
+// AuthJWTKeysLoad Load Every PEM Key in JWT Key Directory
+// File Name Without Extension is Used as Key ID
+// Signing Key is The Configured Key ID or The Last Private Key Sorted by Key ID
+func AuthJWTKeysLoad() error {
+	if len(AuthJWTKeyDir) == 0 {
+		return nil
+	}
+
+	keyFiles, err := filepath.Glob(filepath.Join(AuthJWTKeyDir, "*.pem"))
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(keyFiles)
+
+	var signing *AuthJWTKey
+	keys := make(map[string]*AuthJWTKey)
+
+	for _, keyFile := range keyFiles {
+		id := strings.TrimSuffix(filepath.Base(keyFile), ".pem")
+
+		pemBytes, err := os.ReadFile(keyFile)
+		if err != nil {
+			return err
+		}
+
+		key, err := authJWTKeyParse(id, pemBytes)
+		if err != nil {
+			return errors.New(filepath.Base(keyFile) + ": " + err.Error())
+		}
+
+		keys[id] = key
+
+		if key.PrivateKey != nil && (len(AuthJWTKeyID) == 0 || AuthJWTKeyID == id) {
+			signing = key
+		}
+	}
+
+	if signing == nil {
+		return errors.New("There is No Private Key Available for Signing in " + AuthJWTKeyDir)
+	}
+
+	authJWTKeys.Lock()
+	defer authJWTKeys.Unlock()
+
+	authJWTKeys.signing = signing
+	authJWTKeys.data = keys
+
+	return nil
+}
+
+// AuthJWTSign Sign JWT Claims Using Active Signing Key
+// HMAC Secret is Used When JWT Key Directory is Not Configured
+func AuthJWTSign(claims jwt.Claims) (string, error) {
+	authJWTKeys.RLock()
+	signing := authJWTKeys.signing
+	authJWTKeys.RUnlock()
+
+	if signing == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(AuthJWTSecret))
+	}
+
+	jwtToken := jwt.NewWithClaims(signing.Method, claims)
+	jwtToken.Header["kid"] = signing.ID
+
+	return jwtToken.SignedString(signing.PrivateKey)
+}
+
+// AuthJWTKeyFunc Get Verification Key for JWT Token Based on Its Key ID
+// Signing Method Should Match With The Key Type to Prevent Algorithm Confusion
+func AuthJWTKeyFunc(jwtToken *jwt.Token) (interface{}, error) {
+	authJWTKeys.RLock()
+	defer authJWTKeys.RUnlock()
+
+	if authJWTKeys.signing == nil {
+		if jwtToken.Method.Alg() != jwt.SigningMethodHS256.Alg() {
+			return nil, errors.New("Unexpected JWT Signing Method " + jwtToken.Method.Alg())
+		}
+
+		return []byte(AuthJWTSecret), nil
+	}
+
+	id, _ := jwtToken.Header["kid"].(string)
+
+	key := authJWTKeys.data[id]
+	if key == nil {
+		return nil, errors.New("Unknown JWT Key ID " + id)
+	}
+
+	if jwtToken.Method.Alg() != key.Method.Alg() {
+		return nil, errors.New("Unexpected JWT Signing Method " + jwtToken.Method.Alg())
+	}
+
+	return key.PublicKey, nil
+}
+
+// AuthJWKS Get Every Active Public Key in JSON Web Key Set Format
+// HMAC Secret is Never Published
+func AuthJWKS() AuthJWKSet {
+	authJWTKeys.RLock()
+	defer authJWTKeys.RUnlock()
+
+	jwks := AuthJWKSet{
+		Keys: []AuthJWK{},
+	}
+
+	ids := make([]string, 0, len(authJWTKeys.data))
+	for id := range authJWTKeys.data {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		key := authJWTKeys.data[id]
+
+		jwk := AuthJWK{
+			KeyID:     key.ID,
+			Use:       "sig",
+			Algorithm: key.Method.Alg(),
+		}
+
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks
+}
@@ -0,0 +1,325 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+func jwtTestPEM(t *testing.T, blockType string, der []byte) []byte {
+	t.Helper()
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func jwtTestPKCS8(t *testing.T, privateKey interface{}) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return jwtTestPEM(t, "PRIVATE KEY", der)
+}
+
+func jwtTestPKIX(t *testing.T, publicKey interface{}) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return jwtTestPEM(t, "PUBLIC KEY", der)
+}
+
+// jwtTestKeySet Write RSA, Ed25519 and Retired Public Only Key to Temporary Key Directory
+// And Load Them as Active Key Set
+func jwtTestKeySet(t *testing.T) (*rsa.PrivateKey, ed25519.PrivateKey, *rsa.PrivateKey) {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	retiredKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"a-rsa.pem":     jwtTestPKCS8(t, rsaKey),
+		"b-ed25519.pem": jwtTestPKCS8(t, edKey),
+		"0-retired.pem": jwtTestPKIX(t, &retiredKey.PublicKey),
+		"ignored.txt":   []byte("not a key"),
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := jwtTestLoad(t, dir, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	return rsaKey, edKey, retiredKey
+}
+
+// jwtTestLoad Load Key Directory and Restore Key Set When Test is Finished
+func jwtTestLoad(t *testing.T, dir string, id string) error {
+	t.Helper()
+
+	keyDir, keyID := AuthJWTKeyDir, AuthJWTKeyID
+	t.Cleanup(func() {
+		AuthJWTKeyDir, AuthJWTKeyID = keyDir, keyID
+
+		authJWTKeys.Lock()
+		authJWTKeys.signing = nil
+		authJWTKeys.data = make(map[string]*AuthJWTKey)
+		authJWTKeys.Unlock()
+	})
+
+	AuthJWTKeyDir, AuthJWTKeyID = dir, id
+
+	return AuthJWTKeysLoad()
+}
+
+func jwtTestSign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}) string {
+	t.Helper()
+
+	jwtToken := jwt.NewWithClaims(method, jwt.StandardClaims{
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+	})
+	if len(kid) > 0 {
+		jwtToken.Header["kid"] = kid
+	}
+
+	signed, err := jwtToken.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func TestAuthJWTKeyFunc(t *testing.T) {
+	rsaKey, edKey, retiredKey := jwtTestKeySet(t)
+
+	rsaPublicPEM := jwtTestPKIX(t, &rsaKey.PublicKey)
+	edPublicPEM := jwtTestPKIX(t, edKey.Public())
+
+	// Key Function Should Reject Token by Itself Instead of Relying on Signature Verification
+	// Token With Known Key ID and Matching Method But Wrong Signature is Rejected by Verification
+	tests := []struct {
+		name          string
+		token         string
+		isValid       bool
+		isKeyRejected bool
+	}{
+		{"rsa key", jwtTestSign(t, jwt.SigningMethodRS256, "a-rsa", rsaKey), true, false},
+		{"ed25519 key", jwtTestSign(t, jwt.SigningMethodEdDSA, "b-ed25519", edKey), true, false},
+		{"retired public only key", jwtTestSign(t, jwt.SigningMethodRS256, "0-retired", retiredKey), true, false},
+		{"hs256 using rsa public key", jwtTestSign(t, jwt.SigningMethodHS256, "a-rsa", rsaPublicPEM), false, true},
+		{"hs256 using ed25519 public key", jwtTestSign(t, jwt.SigningMethodHS256, "b-ed25519", edPublicPEM), false, true},
+		{"hs256 using retired public key", jwtTestSign(t, jwt.SigningMethodHS256, "0-retired", jwtTestPKIX(t, &retiredKey.PublicKey)), false, true},
+		{"hs256 using jwt secret", jwtTestSign(t, jwt.SigningMethodHS256, "", []byte(AuthJWTSecret)), false, true},
+		{"rs256 against ed25519 key", jwtTestSign(t, jwt.SigningMethodRS256, "b-ed25519", rsaKey), false, true},
+		{"eddsa against rsa key", jwtTestSign(t, jwt.SigningMethodEdDSA, "a-rsa", edKey), false, true},
+		{"unknown kid", jwtTestSign(t, jwt.SigningMethodRS256, "unknown", rsaKey), false, true},
+		{"missing kid", jwtTestSign(t, jwt.SigningMethodRS256, "", rsaKey), false, true},
+		{"wrong key for kid", jwtTestSign(t, jwt.SigningMethodRS256, "a-rsa", retiredKey), false, false},
+	}
+
+	for _, test := range tests {
+		unverifiedToken, _, err := new(jwt.Parser).ParseUnverified(test.token, jwt.MapClaims{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = AuthJWTKeyFunc(unverifiedToken); (err != nil) != test.isKeyRejected {
+			t.Errorf("%s: key rejected = %v, want %v", test.name, err != nil, test.isKeyRejected)
+		}
+
+		jwtToken, err := jwt.Parse(test.token, AuthJWTKeyFunc)
+		if isValid := err == nil && jwtToken.Valid; isValid != test.isValid {
+			t.Errorf("%s: valid = %v, want %v (%v)", test.name, isValid, test.isValid, err)
+		}
+	}
+}
+
+func TestAuthJWTSign(t *testing.T) {
+	_, edKey, _ := jwtTestKeySet(t)
+
+	// Last Private Key Sorted by Key ID is Used for Signing When Key ID is Not Configured
+	signed, err := AuthJWTSign(jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Minute).Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwtToken, err := jwt.Parse(signed, func(jwtToken *jwt.Token) (interface{}, error) {
+		return edKey.Public(), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if kid := jwtToken.Header["kid"]; kid != "b-ed25519" {
+		t.Errorf("kid = %v, want b-ed25519", kid)
+	}
+
+	if jwtToken.Method.Alg() != jwt.SigningMethodEdDSA.Alg() {
+		t.Errorf("alg = %s, want EdDSA", jwtToken.Method.Alg())
+	}
+}
+
+func TestAuthJWTKeysLoadPublicOnly(t *testing.T) {
+	retiredKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+
+	err = os.WriteFile(filepath.Join(dir, "retired.pem"), jwtTestPKIX(t, &retiredKey.PublicKey), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = jwtTestLoad(t, dir, ""); err == nil {
+		t.Error("key set without private key is loaded")
+	}
+
+	// Public Only Key Can Not Be Configured as Signing Key
+	if err = jwtTestLoad(t, dir, "retired"); err == nil {
+		t.Error("public only key is used as signing key")
+	}
+}
+
+func TestAuthJWKS(t *testing.T) {
+	rsaKey, edKey, retiredKey := jwtTestKeySet(t)
+
+	jwks := AuthJWKS()
+	if len(jwks.Keys) != 3 {
+		t.Fatalf("len(keys) = %d, want 3", len(jwks.Keys))
+	}
+
+	rsaPublicKeys := map[string]*rsa.PublicKey{
+		"0-retired": &retiredKey.PublicKey,
+		"a-rsa":     &rsaKey.PublicKey,
+	}
+
+	wantIDs := []string{"0-retired", "a-rsa", "b-ed25519"}
+	for i, jwk := range jwks.Keys {
+		if jwk.KeyID != wantIDs[i] {
+			t.Errorf("keys[%d].kid = %s, want %s", i, jwk.KeyID, wantIDs[i])
+		}
+
+		if jwk.Use != "sig" {
+			t.Errorf("%s: use = %s, want sig", jwk.KeyID, jwk.Use)
+		}
+
+		if publicKey, isRSA := rsaPublicKeys[jwk.KeyID]; isRSA {
+			n, _ := base64.RawURLEncoding.DecodeString(jwk.N)
+			e, _ := base64.RawURLEncoding.DecodeString(jwk.E)
+
+			if jwk.KeyType != "RSA" || jwk.Algorithm != "RS256" {
+				t.Errorf("%s: kty = %s, alg = %s, want RSA RS256", jwk.KeyID, jwk.KeyType, jwk.Algorithm)
+			}
+
+			if new(big.Int).SetBytes(n).Cmp(publicKey.N) != 0 || int(new(big.Int).SetBytes(e).Int64()) != publicKey.E {
+				t.Errorf("%s: modulus or exponent does not match public key", jwk.KeyID)
+			}
+
+			continue
+		}
+
+		x, _ := base64.RawURLEncoding.DecodeString(jwk.X)
+
+		if jwk.KeyType != "OKP" || jwk.Curve != "Ed25519" || jwk.Algorithm != "EdDSA" {
+			t.Errorf("%s: kty = %s, crv = %s, alg = %s, want OKP Ed25519 EdDSA", jwk.KeyID, jwk.KeyType, jwk.Curve, jwk.Algorithm)
+		}
+
+		if !edKey.Public().(ed25519.PublicKey).Equal(ed25519.PublicKey(x)) {
+			t.Errorf("%s: x does not match public key", jwk.KeyID)
+		}
+	}
+}
+
+func TestAuthJWTKeyParse(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edPublicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		pem       []byte
+		method    jwt.SigningMethod
+		isPrivate bool
+	}{
+		{"pkcs8 rsa private key", jwtTestPKCS8(t, rsaKey), jwt.SigningMethodRS256, true},
+		{"pkcs1 rsa private key", jwtTestPEM(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)), jwt.SigningMethodRS256, true},
+		{"pkcs8 ed25519 private key", jwtTestPKCS8(t, edKey), jwt.SigningMethodEdDSA, true},
+		{"pkix rsa public key", jwtTestPKIX(t, &rsaKey.PublicKey), jwt.SigningMethodRS256, false},
+		{"pkcs1 rsa public key", jwtTestPEM(t, "RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)), jwt.SigningMethodRS256, false},
+		{"pkix ed25519 public key", jwtTestPKIX(t, edPublicKey), jwt.SigningMethodEdDSA, false},
+		{"ecdsa private key", jwtTestPKCS8(t, ecKey), nil, false},
+		{"ecdsa public key", jwtTestPKIX(t, &ecKey.PublicKey), nil, false},
+		{"not pem encoded", []byte("not a key"), nil, false},
+	}
+
+	for _, test := range tests {
+		key, err := authJWTKeyParse("kid", test.pem)
+		if test.method == nil {
+			if err == nil {
+				t.Errorf("%s: key is accepted", test.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+
+		if key.Method != test.method {
+			t.Errorf("%s: method = %s, want %s", test.name, key.Method.Alg(), test.method.Alg())
+		}
+
+		if (key.PrivateKey != nil) != test.isPrivate {
+			t.Errorf("%s: has private key = %v, want %v", test.name, key.PrivateKey != nil, test.isPrivate)
+		}
+	}
+}
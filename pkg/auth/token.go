@@ -0,0 +1,251 @@
+package auth
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+)
+
+const (
+	AuthTokenKindAccess  = "access"
+	AuthTokenKindRefresh = "refresh"
+)
+
+type AuthRefreshToken struct {
+	Family   string
+	JID      string
+	Username string
+	IsAdmin  bool
+}
+
+var authTokenMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE auth_token (
+				jti        TEXT PRIMARY KEY,
+				family     TEXT NOT NULL,
+				kind       TEXT NOT NULL,
+				jid        TEXT NOT NULL,
+				username   TEXT NOT NULL,
+				is_admin   BOOLEAN NOT NULL,
+				token_hash TEXT,
+				created_at TIMESTAMP NOT NULL,
+				expires_at TIMESTAMP NOT NULL,
+				used_at    TIMESTAMP,
+				revoked_at TIMESTAMP
+			)`,
+			`CREATE UNIQUE INDEX auth_token_hash_idx ON auth_token (token_hash)`,
+			`CREATE INDEX auth_token_family_idx ON auth_token (family)`,
+			`CREATE INDEX auth_token_jid_idx ON auth_token (jid)`,
+			`CREATE INDEX auth_token_username_idx ON auth_token (username)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE auth_token (
+				jti        TEXT PRIMARY KEY,
+				family     TEXT NOT NULL,
+				kind       TEXT NOT NULL,
+				jid        TEXT NOT NULL,
+				username   TEXT NOT NULL,
+				is_admin   BOOLEAN NOT NULL,
+				token_hash TEXT,
+				created_at TIMESTAMPTZ NOT NULL,
+				expires_at TIMESTAMPTZ NOT NULL,
+				used_at    TIMESTAMPTZ,
+				revoked_at TIMESTAMPTZ
+			)`,
+			`CREATE UNIQUE INDEX auth_token_hash_idx ON auth_token (token_hash)`,
+			`CREATE INDEX auth_token_family_idx ON auth_token (family)`,
+			`CREATE INDEX auth_token_jid_idx ON auth_token (jid)`,
+			`CREATE INDEX auth_token_username_idx ON auth_token (username)`,
+		},
+	},
+}
+
+func init() {
+	datastore.Register("auth_token", authTokenMigrations)
+}
+
+// AuthTokenNewID Generate Random Token ID Used as JWT ID or Token Family
+func AuthTokenNewID() (string, error) {
+	idBytes := make([]byte, 16)
+
+	_, err := rand.Read(idBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(idBytes), nil
+}
+
+// AuthTokenSaveAccess Record Issued Access Token So It Can Be Revoked
+func AuthTokenSaveAccess(jti string, family string, jid string, username string, isAdmin bool, expiresAt time.Time) error {
+	_, err := datastore.DB.Exec(`INSERT INTO auth_token (jti, family, kind, jid, username, is_admin, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		jti, family, AuthTokenKindAccess, jid, username, isAdmin, time.Now().UTC(), expiresAt.UTC())
+
+	return err
+}
+
+// AuthTokenCreateRefresh Create New Refresh Token in Token Family
+// The Plain Refresh Token is Only Returned Once and Never Stored
+func AuthTokenCreateRefresh(family string, jid string, username string, isAdmin bool) (string, error) {
+	jti, err := AuthTokenNewID()
+	if err != nil {
+		return "", err
+	}
+
+	secretBytes := make([]byte, 32)
+
+	_, err = rand.Read(secretBytes)
+	if err != nil {
+		return "", err
+	}
+
+	refreshToken := base64.RawURLEncoding.EncodeToString(secretBytes)
+	now := time.Now().UTC()
+
+	_, err = datastore.DB.Exec(`INSERT INTO auth_token (jti, family, kind, jid, username, is_admin, token_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		jti, family, AuthTokenKindRefresh, jid, username, isAdmin, authHashToken(refreshToken), now,
+		now.Add(time.Hour*time.Duration(AuthJWTRefreshExpiredHour)))
+	if err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
+
+// AuthTokenUseRefresh Consume Refresh Token, Every Refresh Token Can Only Be Used Once
+// Reusing Consumed Refresh Token Will Revoke The Whole Token Family
+func AuthTokenUseRefresh(refreshToken string) (*AuthRefreshToken, error) {
+	var result AuthRefreshToken
+	var expiresAt time.Time
+	var usedAt, revokedAt sql.NullTime
+
+	tokenHash := authHashToken(refreshToken)
+
+	err := datastore.DB.QueryRow("SELECT family, jid, username, is_admin, expires_at, used_at, revoked_at FROM auth_token WHERE token_hash=$1 AND kind=$2",
+		tokenHash, AuthTokenKindRefresh).
+		Scan(&result.Family, &result.JID, &result.Username, &result.IsAdmin, &expiresAt, &usedAt, &revokedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("Invalid Refresh Token")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		return nil, errors.New("Refresh Token is Revoked")
+	}
+
+	if usedAt.Valid {
+		_ = AuthTokenRevokeFamily(result.Family)
+		return nil, errors.New("Refresh Token is Already Used, Every Token in The Same Session is Revoked")
+	}
+
+	now := time.Now().UTC()
+	if now.After(expiresAt) {
+		return nil, errors.New("Refresh Token is Expired")
+	}
+
+	// Make Sure Concurrent Refresh Only Succeed Once
+	res, err := datastore.DB.Exec("UPDATE auth_token SET used_at=$1 WHERE token_hash=$2 AND used_at IS NULL AND revoked_at IS NULL",
+		now, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		_ = AuthTokenRevokeFamily(result.Family)
+		return nil, errors.New("Refresh Token is Already Used, Every Token in The Same Session is Revoked")
+	}
+
+	return &result, nil
+}
+
+// AuthTokenIsRevoked Check if Access Token is Revoked
+// Access Token That is Not Recorded is Treated as Revoked
+func AuthTokenIsRevoked(jti string) (bool, error) {
+	var revokedAt sql.NullTime
+
+	err := datastore.DB.QueryRow("SELECT revoked_at FROM auth_token WHERE jti=$1 AND kind=$2", jti, AuthTokenKindAccess).
+		Scan(&revokedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return revokedAt.Valid, nil
+}
+
+// AuthTokenFamily Get Token Family of Access Token
+func AuthTokenFamily(jti string) (string, error) {
+	var family string
+
+	err := datastore.DB.QueryRow("SELECT family FROM auth_token WHERE jti=$1", jti).Scan(&family)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errors.New("Token is Not Found")
+	}
+
+	return family, err
+}
+
+// AuthTokenUsername Get Username of Access Token Owner
+func AuthTokenUsername(jti string) (string, error) {
+	var username string
+
+	err := datastore.DB.QueryRow("SELECT username FROM auth_token WHERE jti=$1", jti).Scan(&username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errors.New("Token is Not Found")
+	}
+
+	return username, err
+}
+
+// AuthTokenRefreshFamily Get Token Family and JID of Refresh Token
+func AuthTokenRefreshFamily(refreshToken string) (string, string, error) {
+	var family, jid string
+
+	err := datastore.DB.QueryRow("SELECT family, jid FROM auth_token WHERE token_hash=$1 AND kind=$2",
+		authHashToken(refreshToken), AuthTokenKindRefresh).Scan(&family, &jid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", errors.New("Invalid Refresh Token")
+	}
+
+	return family, jid, err
+}
+
+// AuthTokenRevokeFamily Revoke Every Access and Refresh Token in Token Family
+func AuthTokenRevokeFamily(family string) error {
+	_, err := datastore.DB.Exec("UPDATE auth_token SET revoked_at=$1 WHERE family=$2 AND revoked_at IS NULL",
+		time.Now().UTC(), family)
+
+	return err
+}
+
+// AuthTokenRevokeJID Revoke Every Access and Refresh Token Issued for JID
+func AuthTokenRevokeJID(jid string) error {
+	_, err := datastore.DB.Exec("UPDATE auth_token SET revoked_at=$1 WHERE jid=$2 AND revoked_at IS NULL",
+		time.Now().UTC(), jid)
+
+	return err
+}
+
+// AuthTokenRevokeUser Revoke Every Access and Refresh Token Issued for User
+func AuthTokenRevokeUser(username string) error {
+	_, err := datastore.DB.Exec("UPDATE auth_token SET revoked_at=$1 WHERE username=$2 AND revoked_at IS NULL",
+		time.Now().UTC(), username)
+
+	return err
+}
+
+// AuthTokenPurgeExpired Remove Expired Token From Revocation Store
+func AuthTokenPurgeExpired() error {
+	_, err := datastore.DB.Exec("DELETE FROM auth_token WHERE expires_at<$1", time.Now().UTC())
+	return err
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAuthTokenUseRefreshReuse(t *testing.T) {
+	family, err := AuthTokenNewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jti, err := AuthTokenNewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = AuthTokenSaveAccess(jti, family, "6281", "alice", false, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refreshToken, err := AuthTokenCreateRefresh(family, "6281", "alice", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := AuthTokenUseRefresh(refreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Family != family || result.JID != "6281" || result.Username != "alice" {
+		t.Errorf("AuthTokenUseRefresh = %+v, want family %s", result, family)
+	}
+
+	// Rotated Refresh Token Issued Before The Reuse Should Be Revoked Too
+	rotatedToken, err := AuthTokenCreateRefresh(family, "6281", "alice", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = AuthTokenUseRefresh(refreshToken); err == nil {
+		t.Fatal("reused refresh token is accepted")
+	}
+
+	if isRevoked, err := AuthTokenIsRevoked(jti); err != nil || !isRevoked {
+		t.Errorf("AuthTokenIsRevoked = %v, %v, want true after reuse", isRevoked, err)
+	}
+
+	if _, err = AuthTokenUseRefresh(rotatedToken); err == nil {
+		t.Error("refresh token in reused family is accepted")
+	}
+}
+
+func TestAuthTokenRevokeUser(t *testing.T) {
+	family, err := AuthTokenNewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aliceJTI, err := AuthTokenNewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bobJTI, err := AuthTokenNewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expiresAt := time.Now().Add(time.Minute)
+
+	if err = AuthTokenSaveAccess(aliceJTI, family, "6281", "alice", false, expiresAt); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = AuthTokenSaveAccess(bobJTI, family+"-bob", "6281", "bob", false, expiresAt); err != nil {
+		t.Fatal(err)
+	}
+
+	refreshToken, err := AuthTokenCreateRefresh(family, "6281", "alice", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = AuthTokenRevokeUser("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if isRevoked, _ := AuthTokenIsRevoked(aliceJTI); !isRevoked {
+		t.Error("access token is still valid after its user is revoked")
+	}
+
+	if _, err = AuthTokenUseRefresh(refreshToken); err == nil {
+		t.Error("refresh token is still valid after its user is revoked")
+	}
+
+	if isRevoked, _ := AuthTokenIsRevoked(bobJTI); isRevoked {
+		t.Error("access token of other user is revoked")
+	}
+}
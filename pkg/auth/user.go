@@ -0,0 +1,385 @@
+package auth
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+)
+
+type AuthUser struct {
+	Username   string    `json:"username"`
+	JIDs       []string  `json:"jids"`
+	IsDisabled bool      `json:"is_disabled"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+var authUserMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE auth_user (
+				username      TEXT PRIMARY KEY,
+				password_hash TEXT NOT NULL,
+				is_disabled   BOOLEAN NOT NULL,
+				created_at    TIMESTAMP NOT NULL,
+				updated_at    TIMESTAMP NOT NULL
+			)`,
+			`CREATE TABLE auth_user_jid (
+				username TEXT NOT NULL REFERENCES auth_user (username) ON DELETE CASCADE,
+				jid      TEXT NOT NULL,
+				PRIMARY KEY (username, jid)
+			)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE auth_user (
+				username      TEXT PRIMARY KEY,
+				password_hash TEXT NOT NULL,
+				is_disabled   BOOLEAN NOT NULL,
+				created_at    TIMESTAMPTZ NOT NULL,
+				updated_at    TIMESTAMPTZ NOT NULL
+			)`,
+			`CREATE TABLE auth_user_jid (
+				username TEXT NOT NULL REFERENCES auth_user (username) ON DELETE CASCADE,
+				jid      TEXT NOT NULL,
+				PRIMARY KEY (username, jid)
+			)`,
+		},
+	},
+}
+
+var ErrAuthUserNotFound = errors.New("User is Not Found")
+
+// Dummy Hash Used to Keep Verification Time Constant
+// When Username is Not Exist
+var authUserDummyHash, _ = bcrypt.GenerateFromPassword([]byte("ThisIsDummyPassword"), bcrypt.DefaultCost)
+
+func init() {
+	datastore.Register("auth_user", authUserMigrations)
+}
+
+// AuthGeneratePassword Generate Random URL Safe Password
+func AuthGeneratePassword() (string, error) {
+	passwordBytes := make([]byte, 24)
+
+	_, err := rand.Read(passwordBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(passwordBytes), nil
+}
+
+func authUserNormalizeJIDs(jids []string) []string {
+	var result []string
+	isExist := make(map[string]bool)
+
+	for _, jid := range jids {
+		jid = strings.TrimSpace(jid)
+		if len(jid) > 0 && !isExist[jid] {
+			isExist[jid] = true
+			result = append(result, jid)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// AuthUserCreate Create New User With Hashed Password and Allowed JIDs
+func AuthUserCreate(username string, password string, jids []string) (*AuthUser, error) {
+	username = strings.TrimSpace(username)
+	if len(username) == 0 || strings.ContainsRune(username, ':') {
+		return nil, errors.New("Username Should Not be Empty or Contains Colon")
+	}
+
+	if len(password) < 8 {
+		return nil, errors.New("Password Should be at Least 8 Characters")
+	}
+
+	jids = authUserNormalizeJIDs(jids)
+	if len(jids) == 0 {
+		return nil, errors.New("User Should Have at Least One Allowed JID")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	tx, err := datastore.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var isExist int
+	err = tx.QueryRow("SELECT COUNT(*) FROM auth_user WHERE username=$1", username).Scan(&isExist)
+	if err != nil {
+		return nil, err
+	}
+
+	if isExist > 0 {
+		return nil, errors.New("User is Already Exist")
+	}
+
+	_, err = tx.Exec("INSERT INTO auth_user (username, password_hash, is_disabled, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)",
+		username, string(passwordHash), false, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, jid := range jids {
+		_, err = tx.Exec("INSERT INTO auth_user_jid (username, jid) VALUES ($1, $2)", username, jid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return AuthUserGet(username)
+}
+
+// AuthUserGet Get User and Allowed JIDs
+func AuthUserGet(username string) (*AuthUser, error) {
+	var user AuthUser
+
+	err := datastore.DB.QueryRow("SELECT username, is_disabled, created_at, updated_at FROM auth_user WHERE username=$1", username).
+		Scan(&user.Username, &user.IsDisabled, &user.CreatedAt, &user.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAuthUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	user.JIDs, err = authUserJIDs(username)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func authUserJIDs(username string) ([]string, error) {
+	rows, err := datastore.DB.Query("SELECT jid FROM auth_user_jid WHERE username=$1 ORDER BY jid", username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jids := []string{}
+	for rows.Next() {
+		var jid string
+
+		err = rows.Scan(&jid)
+		if err != nil {
+			return nil, err
+		}
+
+		jids = append(jids, jid)
+	}
+
+	return jids, rows.Err()
+}
+
+// AuthUserList Get Every User Ordered by Username
+func AuthUserList() ([]AuthUser, error) {
+	rows, err := datastore.DB.Query("SELECT username FROM auth_user ORDER BY username")
+	if err != nil {
+		return nil, err
+	}
+
+	var usernames []string
+	for rows.Next() {
+		var username string
+
+		err = rows.Scan(&username)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		usernames = append(usernames, username)
+	}
+
+	rows.Close()
+
+	users := []AuthUser{}
+	for _, username := range usernames {
+		user, err := AuthUserGet(username)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, *user)
+	}
+
+	return users, nil
+}
+
+// AuthUserSetDisabled Disable or Enable User
+func AuthUserSetDisabled(username string, isDisabled bool) (*AuthUser, error) {
+	res, err := datastore.DB.Exec("UPDATE auth_user SET is_disabled=$1, updated_at=$2 WHERE username=$3",
+		isDisabled, time.Now().UTC(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, ErrAuthUserNotFound
+	}
+
+	return AuthUserGet(username)
+}
+
+// AuthUserSetJIDs Replace User Allowed JIDs
+func AuthUserSetJIDs(username string, jids []string) (*AuthUser, error) {
+	jids = authUserNormalizeJIDs(jids)
+	if len(jids) == 0 {
+		return nil, errors.New("User Should Have at Least One Allowed JID")
+	}
+
+	tx, err := datastore.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("UPDATE auth_user SET updated_at=$1 WHERE username=$2", time.Now().UTC(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, ErrAuthUserNotFound
+	}
+
+	_, err = tx.Exec("DELETE FROM auth_user_jid WHERE username=$1", username)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, jid := range jids {
+		_, err = tx.Exec("INSERT INTO auth_user_jid (username, jid) VALUES ($1, $2)", username, jid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return AuthUserGet(username)
+}
+
+// AuthUserRotatePassword Replace User Password
+// If Password is Empty Then Random Password Will Be Generated and Returned
+func AuthUserRotatePassword(username string, password string) (string, error) {
+	var err error
+
+	if len(password) == 0 {
+		password, err = AuthGeneratePassword()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if len(password) < 8 {
+		return "", errors.New("Password Should be at Least 8 Characters")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := datastore.DB.Exec("UPDATE auth_user SET password_hash=$1, updated_at=$2 WHERE username=$3",
+		string(passwordHash), time.Now().UTC(), username)
+	if err != nil {
+		return "", err
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return "", ErrAuthUserNotFound
+	}
+
+	return password, nil
+}
+
+// AuthUserDelete Remove User and Allowed JIDs
+func AuthUserDelete(username string) error {
+	tx, err := datastore.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("DELETE FROM auth_user_jid WHERE username=$1", username)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec("DELETE FROM auth_user WHERE username=$1", username)
+	if err != nil {
+		return err
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return ErrAuthUserNotFound
+	}
+
+	return tx.Commit()
+}
+
+// AuthUserVerify Verify User Password and Make Sure User is Not Disabled
+func AuthUserVerify(username string, password string) (*AuthUser, error) {
+	var passwordHash string
+	var isDisabled bool
+
+	err := datastore.DB.QueryRow("SELECT password_hash, is_disabled FROM auth_user WHERE username=$1", username).
+		Scan(&passwordHash, &isDisabled)
+	if errors.Is(err, sql.ErrNoRows) {
+		_ = bcrypt.CompareHashAndPassword(authUserDummyHash, []byte(password))
+		return nil, errors.New("Invalid Authentication")
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password))
+	if err != nil {
+		return nil, errors.New("Invalid Authentication")
+	}
+
+	if isDisabled {
+		return nil, errors.New("User is Disabled")
+	}
+
+	return AuthUserGet(username)
+}
+
+// IsAllowed Check if User is Allowed to Control JID
+func (user *AuthUser) IsAllowed(jid string) bool {
+	for _, allowedJID := range user.JIDs {
+		if allowedJID == jid {
+			return true
+		}
+	}
+
+	return false
+}
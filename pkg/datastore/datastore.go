@@ -0,0 +1,120 @@
+package datastore
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+)
+
+var DB *sql.DB
+var DBType string
+
+// Migration Hold Schema Changes for Both Supported SQL Dialects
+type Migration struct {
+	SQLite   []string
+	Postgres []string
+}
+
+type component struct {
+	name       string
+	migrations []Migration
+}
+
+var components []component
+
+// Register Add Component Migrations to be Applied When Datastore is Connected
+// Should be Called From Package init() So Every Migration is Registered Before Connect
+func Register(name string, migrations []Migration) {
+	components = append(components, component{
+		name:       name,
+		migrations: migrations,
+	})
+}
+
+// Connect Open Datastore From Environment Variable and Apply Every Registered Migration
+func Connect() error {
+	var err error
+
+	DBType, err = env.GetEnvString("WHATSAPP_DATASTORE_TYPE")
+	if err != nil {
+		return errors.New("Error Parse Environment Variable for WhatsApp Client Datastore Type")
+	}
+
+	dbURI, err := env.GetEnvString("WHATSAPP_DATASTORE_URI")
+	if err != nil {
+		return errors.New("Error Parse Environment Variable for WhatsApp Client Datastore URI")
+	}
+
+	if DBType != "sqlite" && DBType != "postgres" {
+		return errors.New("Error WhatsApp Client Datastore Type Should be sqlite or postgres")
+	}
+
+	DB, err = sql.Open(DBType, dbURI)
+	if err != nil {
+		return errors.New("Error Connect WhatsApp Client Datastore")
+	}
+
+	_, err = DB.Exec(`CREATE TABLE IF NOT EXISTS datastore_version (
+		component TEXT PRIMARY KEY,
+		version   INTEGER NOT NULL
+	)`)
+	if err != nil {
+		return errors.New("Error Create Datastore Version Table: " + err.Error())
+	}
+
+	for _, component := range components {
+		err = Migrate(component.name, component.migrations)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate Apply Pending Schema Migrations for Given Component
+// Migration Version is The Index of Migration in The List Plus One
+func Migrate(component string, migrations []Migration) error {
+	var version int
+
+	err := DB.QueryRow("SELECT version FROM datastore_version WHERE component=$1", component).Scan(&version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	for i := version; i < len(migrations); i++ {
+		statements := migrations[i].SQLite
+		if DBType == "postgres" {
+			statements = migrations[i].Postgres
+		}
+
+		tx, err := DB.Begin()
+		if err != nil {
+			return err
+		}
+
+		for _, statement := range statements {
+			_, err = tx.Exec(statement)
+			if err != nil {
+				_ = tx.Rollback()
+				return errors.New("Error Migrate " + component + " Version " + strconv.Itoa(i+1) + ": " + err.Error())
+			}
+		}
+
+		_, err = tx.Exec(`INSERT INTO datastore_version (component, version) VALUES ($1, $2)
+			ON CONFLICT (component) DO UPDATE SET version=excluded.version`, component, i+1)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
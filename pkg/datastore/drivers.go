@@ -1,4 +1,4 @@
-package whatsapp
+package datastore
 
 import (
 	_ "github.com/lib/pq"
@@ -51,5 +51,20 @@ func HttpCacheInMemory(cap int, ttl int) echo.MiddlewareFunc {
 	}
 
 	// Return Cache as Echo Middleware
-	return cache.Middleware()
+	cacheMiddleware := cache.Middleware()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		cacheHandler := cacheMiddleware(next)
+
+		return func(c echo.Context) error {
+			// Cache Key is Only Composed From Request URL
+			// So Authenticated Request Should Not Be Cached
+			// And Long Running Stream Should Not Be Buffered
+			if len(c.Request().Header.Get(echo.HeaderAuthorization)) > 0 || len(c.Request().Header.Get("X-API-Key")) > 0 || HttpIsStream(c) {
+				return next(c)
+			}
+
+			return cacheHandler(c)
+		}
+	}
 }
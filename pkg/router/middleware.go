@@ -25,3 +25,18 @@ func HttpRealIP() echo.MiddlewareFunc {
 		}
 	}
 }
+
+// HttpIsStream Check if Request is Server-Sent Events or WebSocket Request
+func HttpIsStream(c echo.Context) bool {
+	for _, streamPath := range []string{"/events/stream", "/events/ws", "/login/stream"} {
+		if strings.HasSuffix(c.Request().URL.Path, streamPath) {
+			return true
+		}
+	}
+
+	if strings.Contains(c.Request().Header.Get(echo.HeaderAccept), "text/event-stream") {
+		return true
+	}
+
+	return strings.EqualFold(c.Request().Header.Get(echo.HeaderUpgrade), "websocket")
+}
@@ -139,6 +139,21 @@ func ResponseUnauthorized(c echo.Context, message string) error {
 	return c.JSON(response.Code, response)
 }
 
+func ResponseForbidden(c echo.Context, message string) error {
+	var response ResError
+
+	response.Status = false
+	response.Code = http.StatusForbidden
+
+	if strings.TrimSpace(message) == "" {
+		message = http.StatusText(response.Code)
+	}
+	response.Error = message
+
+	logError(c, response.Code, response.Error)
+	return c.JSON(response.Code, response)
+}
+
 func ResponseBadRequest(c echo.Context, message string) error {
 	var response ResError
 
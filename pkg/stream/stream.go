@@ -0,0 +1,202 @@
+package stream
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+	pkgWhatsApp "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/whatsapp"
+)
+
+type StreamEvent struct {
+	Seq   uint64
+	Event *pkgWhatsApp.Event
+}
+
+// StreamReset Tell Client That Some Events After Last Event ID Are No Longer Buffered
+// So Client Should Resynchronize Its State Instead of Relying on Replayed Events
+type StreamReset struct {
+	Type          string `json:"type"`
+	LastEventID   uint64 `json:"last_event_id"`
+	OldestEventID uint64 `json:"oldest_event_id"`
+}
+
+type StreamFilter struct {
+	Types map[string]bool
+	Chats map[string]bool
+}
+
+const StreamEventReset = "reset"
+
+type Subscriber struct {
+	Events <-chan StreamEvent
+	Reset  *StreamReset
+
+	events chan StreamEvent
+	filter StreamFilter
+	hub    *streamHub
+	once   sync.Once
+}
+
+type streamHub struct {
+	sync.Mutex
+	seq         uint64
+	buffer      []StreamEvent
+	subscribers map[*Subscriber]bool
+}
+
+var (
+	StreamBufferSize int
+	StreamHeartbeat  time.Duration
+)
+
+var streamHubs = struct {
+	sync.Mutex
+	data map[string]*streamHub
+}{
+	data: make(map[string]*streamHub),
+}
+
+func init() {
+	var err error
+
+	StreamBufferSize, err = env.GetEnvInt("WHATSAPP_STREAM_BUFFER_SIZE")
+	if err != nil || StreamBufferSize <= 0 {
+		StreamBufferSize = 256
+	}
+
+	streamHeartbeat, err := env.GetEnvInt("WHATSAPP_STREAM_HEARTBEAT")
+	if err != nil || streamHeartbeat <= 0 {
+		streamHeartbeat = 15
+	}
+
+	StreamHeartbeat = time.Duration(streamHeartbeat) * time.Second
+}
+
+// Match Check if Event Match With Filter
+// Empty Filter Section Will Match Every Event
+func (filter StreamFilter) Match(evt *pkgWhatsApp.Event) bool {
+	if len(filter.Types) > 0 && !filter.Types[evt.Type] {
+		return false
+	}
+
+	if len(filter.Chats) > 0 && !filter.Chats[pkgWhatsApp.WhatsAppEventChat(evt)] {
+		return false
+	}
+
+	return true
+}
+
+func streamGetHub(jid string) *streamHub {
+	streamHubs.Lock()
+	defer streamHubs.Unlock()
+
+	hub := streamHubs.data[jid]
+	if hub == nil {
+		hub = &streamHub{
+			subscribers: make(map[*Subscriber]bool),
+		}
+
+		streamHubs.data[jid] = hub
+	}
+
+	return hub
+}
+
+// StreamPublish Save Event to JID Ring Buffer and Send it to Every Subscriber
+// This Function is Used as WhatsApp Event Listener So It Should Not Block
+func StreamPublish(evt *pkgWhatsApp.Event) {
+	hub := streamGetHub(evt.JID)
+
+	hub.Lock()
+	defer hub.Unlock()
+
+	hub.seq++
+	streamEvent := StreamEvent{
+		Seq:   hub.seq,
+		Event: evt,
+	}
+
+	// Drop The Oldest Event When Ring Buffer is Full
+	if len(hub.buffer) >= StreamBufferSize {
+		hub.buffer = append(hub.buffer[:0], hub.buffer[len(hub.buffer)-StreamBufferSize+1:]...)
+	}
+	hub.buffer = append(hub.buffer, streamEvent)
+
+	for subscriber := range hub.subscribers {
+		if !subscriber.filter.Match(evt) {
+			continue
+		}
+
+		select {
+		case subscriber.events <- streamEvent:
+		default:
+			// Subscriber is Too Slow, Disconnect it
+			// So The Client Can Resume Using Last Event ID
+			delete(hub.subscribers, subscriber)
+			close(subscriber.events)
+		}
+	}
+}
+
+// StreamSubscribe Subscribe to JID Events Matching The Filter
+// Buffered Events With Sequence Greater Than Last Event ID Will Be Replayed First
+func StreamSubscribe(jid string, lastEventID uint64, filter StreamFilter) *Subscriber {
+	hub := streamGetHub(jid)
+
+	hub.Lock()
+	defer hub.Unlock()
+
+	events := make(chan StreamEvent, StreamBufferSize+64)
+
+	subscriber := &Subscriber{
+		Events: events,
+		events: events,
+		filter: filter,
+		hub:    hub,
+	}
+
+	// Sequence Number Greater Than Current Sequence Means
+	// The Client Last Event ID is Came From Before Server Restart
+	// While Oldest Buffered Sequence Greater Than Next Sequence Means
+	// Some Events Were Dropped From Ring Buffer
+	if lastEventID > 0 {
+		oldestEventID := hub.seq + 1
+		if len(hub.buffer) > 0 {
+			oldestEventID = hub.buffer[0].Seq
+		}
+
+		if lastEventID > hub.seq || oldestEventID > lastEventID+1 {
+			subscriber.Reset = &StreamReset{
+				Type:          StreamEventReset,
+				LastEventID:   lastEventID,
+				OldestEventID: oldestEventID,
+			}
+		}
+	}
+
+	if lastEventID > 0 && lastEventID <= hub.seq {
+		for _, streamEvent := range hub.buffer {
+			if streamEvent.Seq > lastEventID && filter.Match(streamEvent.Event) {
+				events <- streamEvent
+			}
+		}
+	}
+
+	hub.subscribers[subscriber] = true
+
+	return subscriber
+}
+
+// Close Unsubscribe From JID Events
+func (subscriber *Subscriber) Close() {
+	subscriber.once.Do(func() {
+		subscriber.hub.Lock()
+		defer subscriber.hub.Unlock()
+
+		if subscriber.hub.subscribers[subscriber] {
+			delete(subscriber.hub.subscribers, subscriber)
+			close(subscriber.events)
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+)
+
+// TestMain Connect In-Memory Datastore Shared by Every Test in Package
+func TestMain(m *testing.M) {
+	os.Setenv("WHATSAPP_DATASTORE_TYPE", "sqlite")
+	os.Setenv("WHATSAPP_DATASTORE_URI", "file::memory:?cache=shared&_pragma=foreign_keys(1)")
+
+	err := datastore.Connect()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,541 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+	pkgWhatsApp "github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/whatsapp"
+)
+
+type Webhook struct {
+	JID       string    `json:"jid"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"`
+	HasSecret bool      `json:"has_secret"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type DeadLetter struct {
+	ID        int64           `json:"id"`
+	JID       string          `json:"jid"`
+	URL       string          `json:"url"`
+	EventID   string          `json:"event_id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	Attempts  int             `json:"attempts"`
+	LastError string          `json:"last_error"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type delivery struct {
+	id        int64
+	webhook   Webhook
+	eventID   string
+	eventType string
+	payload   []byte
+	attempts  int
+}
+
+var (
+	WebhookMaxRetry    int
+	WebhookTimeout     time.Duration
+	WebhookBackoffBase time.Duration
+	WebhookBackoffMax  time.Duration
+)
+
+var webhookQueue chan *delivery
+var webhookClient *http.Client
+
+var webhookCache = struct {
+	sync.RWMutex
+	data map[string]*Webhook
+}{
+	data: make(map[string]*Webhook),
+}
+
+var webhookMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE webhook (
+				jid        TEXT PRIMARY KEY,
+				url        TEXT NOT NULL,
+				secret     TEXT NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL
+			)`,
+			`CREATE TABLE webhook_deadletter (
+				id         INTEGER PRIMARY KEY AUTOINCREMENT,
+				jid        TEXT NOT NULL,
+				url        TEXT NOT NULL,
+				event_id   TEXT NOT NULL,
+				event_type TEXT NOT NULL,
+				payload    TEXT NOT NULL,
+				attempts   INTEGER NOT NULL,
+				last_error TEXT NOT NULL,
+				created_at TIMESTAMP NOT NULL
+			)`,
+			`CREATE INDEX webhook_deadletter_jid_idx ON webhook_deadletter (jid, id)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE webhook (
+				jid        TEXT PRIMARY KEY,
+				url        TEXT NOT NULL,
+				secret     TEXT NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL,
+				updated_at TIMESTAMPTZ NOT NULL
+			)`,
+			`CREATE TABLE webhook_deadletter (
+				id         BIGSERIAL PRIMARY KEY,
+				jid        TEXT NOT NULL,
+				url        TEXT NOT NULL,
+				event_id   TEXT NOT NULL,
+				event_type TEXT NOT NULL,
+				payload    TEXT NOT NULL,
+				attempts   INTEGER NOT NULL,
+				last_error TEXT NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL
+			)`,
+			`CREATE INDEX webhook_deadletter_jid_idx ON webhook_deadletter (jid, id)`,
+		},
+	},
+	{
+		SQLite: []string{
+			`CREATE TABLE webhook_delivery (
+				id              INTEGER PRIMARY KEY AUTOINCREMENT,
+				jid             TEXT NOT NULL,
+				event_id        TEXT NOT NULL,
+				event_type      TEXT NOT NULL,
+				payload         TEXT NOT NULL,
+				attempts        INTEGER NOT NULL,
+				next_attempt_at TIMESTAMP NOT NULL,
+				created_at      TIMESTAMP NOT NULL
+			)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE webhook_delivery (
+				id              BIGSERIAL PRIMARY KEY,
+				jid             TEXT NOT NULL,
+				event_id        TEXT NOT NULL,
+				event_type      TEXT NOT NULL,
+				payload         TEXT NOT NULL,
+				attempts        INTEGER NOT NULL,
+				next_attempt_at TIMESTAMPTZ NOT NULL,
+				created_at      TIMESTAMPTZ NOT NULL
+			)`,
+		},
+	},
+}
+
+func init() {
+	var err error
+
+	datastore.Register("webhook", webhookMigrations)
+
+	WebhookMaxRetry, err = env.GetEnvInt("WHATSAPP_WEBHOOK_MAX_RETRY")
+	if err != nil || WebhookMaxRetry < 0 {
+		WebhookMaxRetry = 5
+	}
+
+	webhookTimeout, err := env.GetEnvInt("WHATSAPP_WEBHOOK_TIMEOUT")
+	if err != nil || webhookTimeout <= 0 {
+		webhookTimeout = 10
+	}
+
+	webhookWorkers, err := env.GetEnvInt("WHATSAPP_WEBHOOK_WORKERS")
+	if err != nil || webhookWorkers <= 0 {
+		webhookWorkers = 4
+	}
+
+	WebhookTimeout = time.Duration(webhookTimeout) * time.Second
+	WebhookBackoffBase = time.Second
+	WebhookBackoffMax = 5 * time.Minute
+
+	// Webhook URL is Set by User So Private Network Address
+	// Should Not Be Reachable Even When Host Resolve to It
+	webhookDialer := &net.Dialer{
+		Timeout: WebhookTimeout,
+		Control: pkgWhatsApp.WhatsAppDialControl,
+	}
+
+	webhookClient = &http.Client{
+		Timeout: WebhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         webhookDialer.DialContext,
+			TLSHandshakeTimeout: WebhookTimeout,
+		},
+	}
+
+	// Start Webhook Delivery Workers
+	webhookQueue = make(chan *delivery, 1024)
+	for i := 0; i < webhookWorkers; i++ {
+		go webhookWorker()
+	}
+}
+
+// WebhookSign Compute HMAC-SHA256 Signature of Payload With Webhook Secret
+func WebhookSign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookSet Create or Update Webhook URL for JID
+// If Secret is Empty Then Random Secret Will Be Generated
+func WebhookSet(jid string, webhookURL string, secret string) (*Webhook, error) {
+	parsedURL, err := url.Parse(webhookURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || len(parsedURL.Host) == 0 {
+		return nil, errors.New("Webhook URL Should be Valid HTTP or HTTPS URL")
+	}
+
+	// Host Name is Checked Again After Resolved While Delivering
+	if strings.EqualFold(parsedURL.Hostname(), "localhost") || (net.ParseIP(parsedURL.Hostname()) != nil &&
+		pkgWhatsApp.WhatsAppDialControl("tcp", net.JoinHostPort(parsedURL.Hostname(), "0"), nil) != nil) {
+		return nil, errors.New("Webhook URL Should Not Point to Private Network Address")
+	}
+
+	if len(secret) == 0 {
+		secretBytes := make([]byte, 32)
+		_, err = rand.Read(secretBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		secret = hex.EncodeToString(secretBytes)
+	}
+
+	now := time.Now().UTC()
+
+	_, err = datastore.DB.Exec(`INSERT INTO webhook (jid, url, secret, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)
+		ON CONFLICT (jid) DO UPDATE SET url=excluded.url, secret=excluded.secret, updated_at=excluded.updated_at`,
+		jid, webhookURL, secret, now)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookCache.Lock()
+	delete(webhookCache.data, jid)
+	webhookCache.Unlock()
+
+	return WebhookGet(jid)
+}
+
+// WebhookGet Get Webhook Configuration for JID
+func WebhookGet(jid string) (*Webhook, error) {
+	webhookCache.RLock()
+	cached, isCached := webhookCache.data[jid]
+	webhookCache.RUnlock()
+
+	if isCached {
+		if cached == nil {
+			return nil, errors.New("Webhook is Not Configured")
+		}
+
+		webhook := *cached
+		webhook.HasSecret = len(webhook.Secret) > 0
+
+		return &webhook, nil
+	}
+
+	var webhook Webhook
+
+	err := datastore.DB.QueryRow("SELECT jid, url, secret, created_at, updated_at FROM webhook WHERE jid=$1", jid).
+		Scan(&webhook.JID, &webhook.URL, &webhook.Secret, &webhook.CreatedAt, &webhook.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		webhookCache.Lock()
+		webhookCache.data[jid] = nil
+		webhookCache.Unlock()
+
+		return nil, errors.New("Webhook is Not Configured")
+	} else if err != nil {
+		return nil, err
+	}
+
+	webhookCache.Lock()
+	webhookCache.data[jid] = &webhook
+	webhookCache.Unlock()
+
+	result := webhook
+	result.HasSecret = len(result.Secret) > 0
+
+	return &result, nil
+}
+
+// WebhookDelete Remove Webhook Configuration and Pending Deliveries for JID
+func WebhookDelete(jid string) error {
+	_, err := datastore.DB.Exec("DELETE FROM webhook WHERE jid=$1", jid)
+	if err != nil {
+		return err
+	}
+
+	_, err = datastore.DB.Exec("DELETE FROM webhook_delivery WHERE jid=$1", jid)
+	if err != nil {
+		return err
+	}
+
+	webhookCache.Lock()
+	delete(webhookCache.data, jid)
+	webhookCache.Unlock()
+
+	return nil
+}
+
+// WebhookDeadLetters List Failed Webhook Deliveries for JID
+func WebhookDeadLetters(jid string, limit int) ([]DeadLetter, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	rows, err := datastore.DB.Query(`SELECT id, jid, url, event_id, event_type, payload, attempts, last_error, created_at
+		FROM webhook_deadletter WHERE jid=$1 ORDER BY id DESC LIMIT $2`, jid, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deadLetters := []DeadLetter{}
+	for rows.Next() {
+		var deadLetter DeadLetter
+		var payload string
+
+		err = rows.Scan(&deadLetter.ID, &deadLetter.JID, &deadLetter.URL, &deadLetter.EventID, &deadLetter.EventType,
+			&payload, &deadLetter.Attempts, &deadLetter.LastError, &deadLetter.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		deadLetter.Payload = json.RawMessage(payload)
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return deadLetters, rows.Err()
+}
+
+// WebhookDeadLettersPurge Remove Every Failed Webhook Delivery for JID
+func WebhookDeadLettersPurge(jid string) error {
+	_, err := datastore.DB.Exec("DELETE FROM webhook_deadletter WHERE jid=$1", jid)
+	return err
+}
+
+// WebhookStart Resume Pending Webhook Deliveries Saved in Datastore
+// Delivery for JID That No Longer Has Webhook Configured is Dropped
+func WebhookStart() {
+	rows, err := datastore.DB.Query(`SELECT id, jid, event_id, event_type, payload, attempts, next_attempt_at
+		FROM webhook_delivery ORDER BY id`)
+	if err != nil {
+		log.Print(nil).Error("Failed to Load Webhook Delivery: " + err.Error())
+		return
+	}
+
+	type pendingDelivery struct {
+		jid         string
+		delivery    *delivery
+		nextAttempt time.Time
+	}
+
+	var pendings []pendingDelivery
+	for rows.Next() {
+		var pending pendingDelivery
+		var payload string
+
+		pending.delivery = &delivery{}
+
+		err = rows.Scan(&pending.delivery.id, &pending.jid, &pending.delivery.eventID, &pending.delivery.eventType,
+			&payload, &pending.delivery.attempts, &pending.nextAttempt)
+		if err != nil {
+			log.Print(nil).Error("Failed to Load Webhook Delivery: " + err.Error())
+			continue
+		}
+
+		pending.delivery.payload = []byte(payload)
+		pendings = append(pendings, pending)
+	}
+
+	rows.Close()
+
+	for _, pending := range pendings {
+		webhook, err := WebhookGet(pending.jid)
+		if err != nil {
+			webhookDeliveryDelete(pending.delivery)
+			continue
+		}
+
+		pending.delivery.webhook = *webhook
+		webhookSchedule(pending.delivery, time.Until(pending.nextAttempt))
+	}
+}
+
+// WebhookDispatch Queue Event Delivery to Webhook Configured for Event JID
+// This Function is Used as WhatsApp Event Listener So It Should Not Block
+func WebhookDispatch(evt *pkgWhatsApp.Event) {
+	webhook, err := WebhookGet(evt.JID)
+	if err != nil {
+		return
+	}
+
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		log.Print(nil).Error("Failed to Encode Webhook Payload: " + err.Error())
+		return
+	}
+
+	// Delivery is Saved by Worker So Event Handler Does Not Wait for Datastore
+	webhookEnqueue(&delivery{
+		webhook:   *webhook,
+		eventID:   evt.ID,
+		eventType: evt.Type,
+		payload:   payload,
+	})
+}
+
+func webhookEnqueue(d *delivery) {
+	select {
+	case webhookQueue <- d:
+	default:
+		webhookDeadLetter(d, "Webhook Delivery Queue is Full")
+	}
+}
+
+func webhookSchedule(d *delivery, delay time.Duration) {
+	if delay <= 0 {
+		webhookEnqueue(d)
+		return
+	}
+
+	time.AfterFunc(delay, func() {
+		webhookEnqueue(d)
+	})
+}
+
+func webhookWorker() {
+	for d := range webhookQueue {
+		webhookProcess(d)
+	}
+}
+
+func webhookProcess(d *delivery) {
+	// Save New Delivery Before First Attempt So It Can Be Resumed After Restart
+	// Delivery is Still Attempted When Saving is Failed
+	if d.id == 0 {
+		webhookDeliverySave(d)
+	}
+
+	d.attempts++
+
+	err := webhookSend(d)
+	if err == nil {
+		webhookDeliveryDelete(d)
+		return
+	}
+
+	if d.attempts > WebhookMaxRetry {
+		webhookDeadLetter(d, err.Error())
+		return
+	}
+
+	backoff := webhookBackoff(d.attempts)
+
+	if d.id > 0 {
+		_, err = datastore.DB.Exec("UPDATE webhook_delivery SET attempts=$1, next_attempt_at=$2 WHERE id=$3",
+			d.attempts, time.Now().Add(backoff).UTC(), d.id)
+		if err != nil {
+			log.Print(nil).Error("Failed to Update Webhook Delivery: " + err.Error())
+		}
+	}
+
+	webhookSchedule(d, backoff)
+}
+
+// Retry With Exponential Backoff Starting From Backoff Base Up to Backoff Max
+func webhookBackoff(attempts int) time.Duration {
+	backoff := WebhookBackoffBase << (attempts - 1)
+	if backoff > WebhookBackoffMax || backoff <= 0 || attempts > 62 {
+		backoff = WebhookBackoffMax
+	}
+
+	return backoff
+}
+
+func webhookDeliverySave(d *delivery) {
+	err := datastore.DB.QueryRow(`INSERT INTO webhook_delivery (jid, event_id, event_type, payload, attempts, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $6) RETURNING id`,
+		d.webhook.JID, d.eventID, d.eventType, string(d.payload), d.attempts, time.Now().UTC()).Scan(&d.id)
+	if err != nil {
+		log.Print(nil).Error("Failed to Save Webhook Delivery: " + err.Error())
+	}
+}
+
+func webhookDeliveryDelete(d *delivery) {
+	if d.id == 0 {
+		return
+	}
+
+	_, err := datastore.DB.Exec("DELETE FROM webhook_delivery WHERE id=$1", d.id)
+	if err != nil {
+		log.Print(nil).Error("Failed to Delete Webhook Delivery: " + err.Error())
+	}
+}
+
+func webhookSend(d *delivery) error {
+	ctx, cancel := context.WithTimeout(context.Background(), WebhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.webhook.URL, bytes.NewReader(d.payload))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-whatsapp-multidevice-rest")
+	req.Header.Set("X-Webhook-ID", d.eventID)
+	req.Header.Set("X-Webhook-Event", d.eventType)
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", WebhookSign(d.webhook.Secret, timestamp, d.payload))
+
+	res, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errors.New("Webhook Returned Status " + res.Status)
+	}
+
+	return nil
+}
+
+func webhookDeadLetter(d *delivery, reason string) {
+	log.Print(nil).Error("Webhook Delivery " + d.eventID + " Moved to Dead-Letter: " + reason)
+
+	webhookDeliveryDelete(d)
+
+	_, err := datastore.DB.Exec(`INSERT INTO webhook_deadletter (jid, url, event_id, event_type, payload, attempts, last_error, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		d.webhook.JID, d.webhook.URL, d.eventID, d.eventType, string(d.payload), d.attempts, reason, time.Now().UTC())
+	if err != nil {
+		log.Print(nil).Error("Failed to Save Webhook Dead-Letter: " + err.Error())
+	}
+}
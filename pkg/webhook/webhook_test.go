@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+)
+
+func TestWebhookSign(t *testing.T) {
+	// Expected Signature is Computed Using
+	// printf '1700000000.{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	want := "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54"
+
+	if got := WebhookSign("secret", "1700000000", []byte(`{"id":"1"}`)); got != want {
+		t.Errorf("WebhookSign = %s, want %s", got, want)
+	}
+
+	if WebhookSign("other", "1700000000", []byte(`{"id":"1"}`)) == want {
+		t.Error("signature does not depend on secret")
+	}
+
+	if WebhookSign("secret", "1700000001", []byte(`{"id":"1"}`)) == want {
+		t.Error("signature does not depend on timestamp")
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := map[int]time.Duration{
+		1:   time.Second,
+		2:   2 * time.Second,
+		3:   4 * time.Second,
+		8:   128 * time.Second,
+		9:   256 * time.Second,
+		10:  5 * time.Minute,
+		64:  5 * time.Minute,
+		100: 5 * time.Minute,
+	}
+
+	for attempts, want := range tests {
+		if got := webhookBackoff(attempts); got != want {
+			t.Errorf("webhookBackoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+func TestWebhookDeadLetter(t *testing.T) {
+	jid := "webhook-test-deadletter"
+
+	err := WebhookDeadLettersPurge(jid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var requests int
+	var signature string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		payload, _ := io.ReadAll(r.Body)
+		if r.Header.Get("X-Webhook-Signature") == WebhookSign("secret", r.Header.Get("X-Webhook-Timestamp"), payload) {
+			signature = "valid"
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	// Local Test Server Can Not Be Reached Using Webhook Client
+	// Retry is Postponed So Delivery is Only Processed by Test
+	client := webhookClient
+	maxRetry := WebhookMaxRetry
+	backoffBase := WebhookBackoffBase
+
+	webhookClient = server.Client()
+	WebhookMaxRetry = 1
+	WebhookBackoffBase = time.Hour
+
+	defer func() {
+		webhookClient = client
+		WebhookMaxRetry = maxRetry
+		WebhookBackoffBase = backoffBase
+	}()
+
+	d := &delivery{
+		webhook:   Webhook{JID: jid, URL: server.URL, Secret: "secret"},
+		eventID:   "EVENT1",
+		eventType: "message",
+		payload:   []byte(`{"id":"EVENT1"}`),
+	}
+
+	// First Failed Attempt is Scheduled for Retry and Saved as Pending Delivery
+	webhookProcess(d)
+
+	var attempts int
+	err = datastore.DB.QueryRow("SELECT attempts FROM webhook_delivery WHERE id=$1", d.id).Scan(&attempts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("pending delivery attempts = %d, want 1", attempts)
+	}
+
+	// Attempt After Max Retry Move Delivery to Dead-Letter
+	webhookProcess(d)
+
+	if requests != 2 || signature != "valid" {
+		t.Errorf("requests = %d signature = %s, want 2 valid signed requests", requests, signature)
+	}
+
+	var pending int
+	err = datastore.DB.QueryRow("SELECT COUNT(*) FROM webhook_delivery WHERE jid=$1", jid).Scan(&pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pending != 0 {
+		t.Errorf("%d pending delivery left after dead-letter", pending)
+	}
+
+	deadLetters, err := WebhookDeadLetters(jid, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deadLetters) != 1 || deadLetters[0].EventID != "EVENT1" || deadLetters[0].Attempts != 2 {
+		t.Fatalf("unexpected dead-letters %+v", deadLetters)
+	}
+
+	if string(deadLetters[0].Payload) != `{"id":"EVENT1"}` {
+		t.Errorf("dead-letter payload = %s", deadLetters[0].Payload)
+	}
+}
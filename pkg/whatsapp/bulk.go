@@ -0,0 +1,702 @@
+package whatsapp
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+)
+
+const (
+	BulkStateRunning   = "running"
+	BulkStateCompleted = "completed"
+
+	BulkRecipientStatePending       = "pending"
+	BulkRecipientStateSending       = "sending"
+	BulkRecipientStateSent          = "sent"
+	BulkRecipientStateQueued        = "queued"
+	BulkRecipientStateNotRegistered = "not_registered"
+	BulkRecipientStateFailed        = "failed"
+)
+
+type BulkRecipientInput struct {
+	MSISDN string
+	Vars   map[string]string
+}
+
+type Bulk struct {
+	ID          int64          `json:"id"`
+	Type        string         `json:"type"`
+	State       string         `json:"state"`
+	Total       int            `json:"total"`
+	States      map[string]int `json:"states"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	CompletedAt *time.Time     `json:"completed_at,omitempty"`
+}
+
+type BulkRecipient struct {
+	ID        int64     `json:"id"`
+	MSISDN    string    `json:"msisdn"`
+	JID       string    `json:"jid,omitempty"`
+	State     string    `json:"state"`
+	MsgID     string    `json:"msgid,omitempty"`
+	JobID     int64     `json:"job_id,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type BulkRecipientQuery struct {
+	State string
+	After int64
+	Limit int
+}
+
+type BulkRecipientPage struct {
+	Recipients []BulkRecipient `json:"recipients"`
+	NextCursor int64           `json:"next_cursor,omitempty"`
+}
+
+type bulkRunner struct {
+	jid string
+	id  int64
+}
+
+type bulkPendingRecipient struct {
+	id     int64
+	msisdn string
+	vars   map[string]string
+}
+
+var (
+	WhatsAppBulkMaxRecipients int
+	WhatsAppBulkCheckSize     int
+	WhatsAppBulkInterval      time.Duration
+)
+
+var ErrBulkNotFound = errors.New("Bulk Message is Not Found")
+
+var bulkRunners = struct {
+	sync.Mutex
+	data map[int64]*bulkRunner
+}{
+	data: make(map[int64]*bulkRunner),
+}
+
+var bulkTemplateVar = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+
+var whatsAppBulkMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE whatsapp_bulk (
+				id           INTEGER PRIMARY KEY AUTOINCREMENT,
+				jid          TEXT NOT NULL,
+				type         TEXT NOT NULL,
+				payload      TEXT NOT NULL,
+				state        TEXT NOT NULL,
+				created_at   TIMESTAMP NOT NULL,
+				updated_at   TIMESTAMP NOT NULL,
+				completed_at TIMESTAMP
+			)`,
+			`CREATE INDEX whatsapp_bulk_jid_idx ON whatsapp_bulk (jid)`,
+			`CREATE TABLE whatsapp_bulk_recipient (
+				id         INTEGER PRIMARY KEY AUTOINCREMENT,
+				bulk_id    INTEGER NOT NULL,
+				msisdn     TEXT NOT NULL,
+				vars       TEXT NOT NULL,
+				rjid       TEXT NOT NULL,
+				state      TEXT NOT NULL,
+				msgid      TEXT NOT NULL,
+				job_id     INTEGER NOT NULL,
+				error      TEXT NOT NULL,
+				updated_at TIMESTAMP NOT NULL
+			)`,
+			`CREATE INDEX whatsapp_bulk_recipient_idx ON whatsapp_bulk_recipient (bulk_id, state, id)`,
+			`CREATE INDEX whatsapp_bulk_recipient_job_idx ON whatsapp_bulk_recipient (job_id)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE whatsapp_bulk (
+				id           BIGSERIAL PRIMARY KEY,
+				jid          TEXT NOT NULL,
+				type         TEXT NOT NULL,
+				payload      TEXT NOT NULL,
+				state        TEXT NOT NULL,
+				created_at   TIMESTAMPTZ NOT NULL,
+				updated_at   TIMESTAMPTZ NOT NULL,
+				completed_at TIMESTAMPTZ
+			)`,
+			`CREATE INDEX whatsapp_bulk_jid_idx ON whatsapp_bulk (jid)`,
+			`CREATE TABLE whatsapp_bulk_recipient (
+				id         BIGSERIAL PRIMARY KEY,
+				bulk_id    BIGINT NOT NULL,
+				msisdn     TEXT NOT NULL,
+				vars       TEXT NOT NULL,
+				rjid       TEXT NOT NULL,
+				state      TEXT NOT NULL,
+				msgid      TEXT NOT NULL,
+				job_id     BIGINT NOT NULL,
+				error      TEXT NOT NULL,
+				updated_at TIMESTAMPTZ NOT NULL
+			)`,
+			`CREATE INDEX whatsapp_bulk_recipient_idx ON whatsapp_bulk_recipient (bulk_id, state, id)`,
+			`CREATE INDEX whatsapp_bulk_recipient_job_idx ON whatsapp_bulk_recipient (job_id)`,
+		},
+	},
+}
+
+func init() {
+	var err error
+
+	WhatsAppBulkMaxRecipients, err = env.GetEnvInt("WHATSAPP_BULK_MAX_RECIPIENTS")
+	if err != nil || WhatsAppBulkMaxRecipients <= 0 {
+		WhatsAppBulkMaxRecipients = 1000
+	}
+
+	WhatsAppBulkCheckSize, err = env.GetEnvInt("WHATSAPP_BULK_CHECK_SIZE")
+	if err != nil || WhatsAppBulkCheckSize <= 0 {
+		WhatsAppBulkCheckSize = 50
+	}
+
+	bulkInterval, err := env.GetEnvInt("WHATSAPP_BULK_INTERVAL")
+	if err != nil || bulkInterval < 0 {
+		bulkInterval = 2
+	}
+
+	WhatsAppBulkInterval = time.Duration(bulkInterval) * time.Second
+
+	datastore.Register("whatsapp_bulk", whatsAppBulkMigrations)
+}
+
+// WhatsAppBulkRender Replace Every {{variable}} in Template With Recipient Variable
+// Unknown Variable is Replaced With Empty String
+func WhatsAppBulkRender(template string, vars map[string]string) string {
+	return bulkTemplateVar.ReplaceAllStringFunc(template, func(match string) string {
+		return vars[bulkTemplateVar.FindStringSubmatch(match)[1]]
+	})
+}
+
+// WhatsAppBulkCreate Save Bulk Message and Its Recipients Then Start Sending in Background
+// Message Text, Caption or Poll Question is Used as Template
+func WhatsAppBulkCreate(jid string, msg *QueueMessage, recipients []BulkRecipientInput) (*Bulk, error) {
+	if WhatsAppSession.Get(jid) == nil {
+		return nil, errors.New("WhatsApp Client is not Valid")
+	}
+
+	if len(recipients) == 0 {
+		return nil, errors.New("Bulk Message Should Have at Least One Recipient")
+	}
+
+	if len(recipients) > WhatsAppBulkMaxRecipients {
+		return nil, errors.New("Bulk Message Recipients Should Not Exceed " + strconv.Itoa(WhatsAppBulkMaxRecipients))
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := datastore.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+
+	var id int64
+	err = tx.QueryRow(`INSERT INTO whatsapp_bulk (jid, type, payload, state, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5) RETURNING id`,
+		jid, msg.Type, string(payload), BulkStateRunning, now).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, recipient := range recipients {
+		vars, err := json.Marshal(recipient.Vars)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Exec(`INSERT INTO whatsapp_bulk_recipient (bulk_id, msisdn, vars, rjid, state, msgid, job_id, error, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			id, recipient.MSISDN, string(vars), "", BulkRecipientStatePending, "", 0, "", now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	whatsAppBulkResume(jid, id)
+
+	return WhatsAppBulkGet(jid, id)
+}
+
+// WhatsAppBulkStart Resume Every Running Bulk Message After Restart
+// Recipient Interrupted While Sending is Marked as Failed Since It May Already Be Sent
+// Should Be Called After Outbound Queue is Started So Queued Recipient Get Its Final Job State
+func WhatsAppBulkStart() {
+	_, err := datastore.DB.Exec("UPDATE whatsapp_bulk_recipient SET state=$1, error=$2, updated_at=$3 WHERE state=$4",
+		BulkRecipientStateFailed, "Interrupted While Sending", time.Now().UTC(), BulkRecipientStateSending)
+	if err != nil {
+		log.Print(nil).Error("Failed to Recover WhatsApp Bulk Recipient: " + err.Error())
+	}
+
+	whatsAppBulkQueueSync()
+
+	whatsAppBulkLoad("SELECT jid, id FROM whatsapp_bulk WHERE state=$1", BulkStateRunning)
+}
+
+// WhatsAppBulkResume Resume Running Bulk Message of JID
+// Used When WhatsApp Client is Connected Again
+func WhatsAppBulkResume(jid string) {
+	whatsAppBulkLoad("SELECT jid, id FROM whatsapp_bulk WHERE state=$1 AND jid=$2", BulkStateRunning, jid)
+}
+
+func whatsAppBulkLoad(sqlQuery string, sqlArgs ...interface{}) {
+	rows, err := datastore.DB.Query(sqlQuery, sqlArgs...)
+	if err != nil {
+		log.Print(nil).Error("Failed to Load WhatsApp Bulk Message: " + err.Error())
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var jid string
+		var id int64
+
+		if rows.Scan(&jid, &id) == nil {
+			whatsAppBulkResume(jid, id)
+		}
+	}
+}
+
+// whatsAppBulkResume Start Bulk Message Runner When It is Not Running Yet
+func whatsAppBulkResume(jid string, id int64) {
+	bulkRunners.Lock()
+	defer bulkRunners.Unlock()
+
+	if bulkRunners.data[id] != nil {
+		return
+	}
+
+	runner := &bulkRunner{
+		jid: jid,
+		id:  id,
+	}
+
+	bulkRunners.data[id] = runner
+	go runner.run()
+}
+
+// pause Remove Runner When WhatsApp Client is Not Ready
+// Runner is Kept When WhatsApp Client is Ready Again While Checking
+func (runner *bulkRunner) pause() bool {
+	bulkRunners.Lock()
+	defer bulkRunners.Unlock()
+
+	if WhatsAppIsClientOK(runner.jid) == nil {
+		return false
+	}
+
+	runner.remove()
+
+	return true
+}
+
+func (runner *bulkRunner) remove() {
+	if bulkRunners.data[runner.id] == runner {
+		delete(bulkRunners.data, runner.id)
+	}
+}
+
+func (runner *bulkRunner) run() {
+	defer func() {
+		bulkRunners.Lock()
+		runner.remove()
+		bulkRunners.Unlock()
+	}()
+
+	jid, id := runner.jid, runner.id
+
+	var payload string
+
+	err := datastore.DB.QueryRow("SELECT payload FROM whatsapp_bulk WHERE id=$1", id).Scan(&payload)
+	if err != nil {
+		log.Print(nil).Error("Failed to Load WhatsApp Bulk Message: " + err.Error())
+		return
+	}
+
+	var msg QueueMessage
+
+	err = json.Unmarshal([]byte(payload), &msg)
+	if err != nil {
+		log.Print(nil).Error("Failed to Load WhatsApp Bulk Message: " + err.Error())
+		return
+	}
+
+	for {
+		// Wait for WhatsApp Client Instead of Failing Every Recipient
+		// Runner is Resumed When WhatsApp Client is Connected Again
+		if WhatsAppIsClientOK(jid) != nil {
+			if runner.pause() {
+				return
+			}
+
+			continue
+		}
+
+		recipients, err := whatsAppBulkPending(id)
+		if err != nil {
+			log.Print(nil).Error("Failed to Load WhatsApp Bulk Recipient: " + err.Error())
+			return
+		}
+
+		if len(recipients) == 0 {
+			break
+		}
+
+		// Check Every Personal Recipient in Chunk Using Single Registered Check
+		var phones []string
+		for _, recipient := range recipients {
+			remoteJID := WhatsAppComposeJID(recipient.msisdn)
+			if remoteJID.Server != types.GroupServer {
+				phones = append(phones, remoteJID.User)
+			}
+		}
+
+		var registered map[string]RegisteredInfo
+		var registeredErr error
+
+		if len(phones) > 0 {
+			registered, registeredErr = WhatsAppRegisteredCheck(jid, phones)
+
+			// WhatsApp Client is Disconnected While Checking
+			if registeredErr != nil && WhatsAppIsClientOK(jid) != nil {
+				continue
+			}
+		}
+
+		for _, recipient := range recipients {
+			remoteJID := WhatsAppComposeJID(recipient.msisdn)
+
+			if remoteJID.Server != types.GroupServer {
+				if registeredErr != nil {
+					if !whatsAppBulkUpdate(recipient.id, "", BulkRecipientStateFailed, "", 0, registeredErr.Error()) {
+						return
+					}
+
+					continue
+				}
+
+				remoteJID = whatsAppRegisteredJID(registered[remoteJID.User])
+				if remoteJID.IsEmpty() {
+					if !whatsAppBulkUpdate(recipient.id, "", BulkRecipientStateNotRegistered, "", 0, "") {
+						return
+					}
+
+					continue
+				}
+			}
+
+			recipientMsg := msg
+			recipientMsg.RJID = remoteJID.String()
+
+			// Document Message Text is Used as File Name
+			if recipientMsg.Type != QueueTypeDocument {
+				recipientMsg.Text = WhatsAppBulkRender(msg.Text, recipient.vars)
+			}
+
+			// Mark Recipient as Sending First So It Will Not Be Sent Again After Crash
+			// Stop Sending When Recipient State Can Not Be Saved to Avoid Sending It Repeatedly
+			if !whatsAppBulkUpdate(recipient.id, recipientMsg.RJID, BulkRecipientStateSending, "", 0, "") {
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), WhatsAppQueueSendTimeout)
+			msgID, jobID, err := WhatsAppQueueSend(ctx, jid, &recipientMsg)
+			cancel()
+
+			var isUpdated, isInterrupted bool
+
+			switch {
+			case err != nil && WhatsAppIsClientOK(jid) != nil:
+				// WhatsApp Client Disconnected While Sending is Retried When It is Connected Again
+				isInterrupted = true
+				isUpdated = whatsAppBulkUpdate(recipient.id, "", BulkRecipientStatePending, "", 0, err.Error())
+
+			case err != nil:
+				isUpdated = whatsAppBulkUpdate(recipient.id, recipientMsg.RJID, BulkRecipientStateFailed, "", 0, err.Error())
+
+			case jobID > 0:
+				isUpdated = whatsAppBulkUpdate(recipient.id, recipientMsg.RJID, BulkRecipientStateQueued, "", jobID, "")
+
+			default:
+				isUpdated = whatsAppBulkUpdate(recipient.id, recipientMsg.RJID, BulkRecipientStateSent, msgID, 0, "")
+			}
+
+			if !isUpdated {
+				return
+			}
+
+			if isInterrupted {
+				break
+			}
+
+			// Queued Message is Already Paced by Outbound Queue Worker
+			if jobID > 0 {
+				continue
+			}
+
+			time.Sleep(WhatsAppBulkInterval)
+		}
+	}
+
+	whatsAppBulkComplete(id)
+}
+
+// whatsAppBulkComplete Mark Bulk Message as Completed When Every Recipient is Finished
+// Bulk Message With Recipient That is Still Queued is Completed by Outbound Queue Worker
+func whatsAppBulkComplete(id int64) {
+	now := time.Now().UTC()
+
+	_, err := datastore.DB.Exec(`UPDATE whatsapp_bulk SET state=$1, updated_at=$2, completed_at=$2 WHERE id=$3 AND state=$4
+		AND NOT EXISTS (SELECT 1 FROM whatsapp_bulk_recipient WHERE bulk_id=$3 AND state IN ($5, $6, $7))`,
+		BulkStateCompleted, now, id, BulkStateRunning,
+		BulkRecipientStatePending, BulkRecipientStateSending, BulkRecipientStateQueued)
+	if err != nil {
+		log.Print(nil).Error("Failed to Update WhatsApp Bulk Message: " + err.Error())
+	}
+}
+
+// whatsAppBulkQueueDone Save Final Outbound Queue Job State to Bulk Recipient
+// Job That is Not Sent From Bulk Message is Ignored
+func whatsAppBulkQueueDone(jobID int64, queueState string, msgID string, errMessage string) {
+	var id, bulkID int64
+
+	err := datastore.DB.QueryRow("SELECT id, bulk_id FROM whatsapp_bulk_recipient WHERE job_id=$1 AND state=$2",
+		jobID, BulkRecipientStateQueued).Scan(&id, &bulkID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return
+	} else if err != nil {
+		log.Print(nil).Error("Failed to Get WhatsApp Bulk Recipient: " + err.Error())
+		return
+	}
+
+	state := BulkRecipientStateFailed
+	if queueState == QueueStateSent {
+		state = BulkRecipientStateSent
+	}
+
+	_, err = datastore.DB.Exec("UPDATE whatsapp_bulk_recipient SET state=$1, msgid=$2, error=$3, updated_at=$4 WHERE id=$5",
+		state, msgID, errMessage, time.Now().UTC(), id)
+	if err != nil {
+		log.Print(nil).Error("Failed to Update WhatsApp Bulk Recipient: " + err.Error())
+		return
+	}
+
+	whatsAppBulkComplete(bulkID)
+}
+
+// whatsAppBulkQueueSync Save Final Outbound Queue Job State to Queued Bulk Recipient
+// Used After Restart for Job That is Finished or Interrupted While Bulk Recipient is Not Updated
+func whatsAppBulkQueueSync() {
+	rows, err := datastore.DB.Query(`SELECT r.job_id, COALESCE(q.state, ''), COALESCE(q.msgid, ''), COALESCE(q.error, '')
+		FROM whatsapp_bulk_recipient r LEFT JOIN whatsapp_queue q ON q.id=r.job_id
+		WHERE r.state=$1 AND (q.id IS NULL OR q.state IN ($2, $3))`,
+		BulkRecipientStateQueued, QueueStateSent, QueueStateFailed)
+	if err != nil {
+		log.Print(nil).Error("Failed to Recover WhatsApp Bulk Recipient: " + err.Error())
+		return
+	}
+
+	type bulkQueueJob struct {
+		id         int64
+		state      string
+		msgID      string
+		errMessage string
+	}
+
+	var jobs []bulkQueueJob
+	for rows.Next() {
+		var job bulkQueueJob
+
+		err = rows.Scan(&job.id, &job.state, &job.msgID, &job.errMessage)
+		if err != nil {
+			rows.Close()
+			log.Print(nil).Error("Failed to Recover WhatsApp Bulk Recipient: " + err.Error())
+			return
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	rows.Close()
+
+	for _, job := range jobs {
+		// Job Removed From Outbound Queue Can Not Be Tracked Anymore
+		if len(job.state) == 0 {
+			job.state, job.errMessage = QueueStateFailed, "Queue Job is Not Found"
+		}
+
+		whatsAppBulkQueueDone(job.id, job.state, job.msgID, job.errMessage)
+	}
+}
+
+func whatsAppBulkPending(id int64) ([]bulkPendingRecipient, error) {
+	rows, err := datastore.DB.Query("SELECT id, msisdn, vars FROM whatsapp_bulk_recipient WHERE bulk_id=$1 AND state=$2 ORDER BY id LIMIT $3",
+		id, BulkRecipientStatePending, WhatsAppBulkCheckSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recipients []bulkPendingRecipient
+	for rows.Next() {
+		var recipient bulkPendingRecipient
+		var vars string
+
+		err = rows.Scan(&recipient.id, &recipient.msisdn, &vars)
+		if err != nil {
+			return nil, err
+		}
+
+		_ = json.Unmarshal([]byte(vars), &recipient.vars)
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients, rows.Err()
+}
+
+// whatsAppBulkUpdate Save Recipient State and Return False When It is Failed
+func whatsAppBulkUpdate(id int64, rjid string, state string, msgID string, jobID int64, errMessage string) bool {
+	_, err := datastore.DB.Exec("UPDATE whatsapp_bulk_recipient SET rjid=$1, state=$2, msgid=$3, job_id=$4, error=$5, updated_at=$6 WHERE id=$7",
+		rjid, state, msgID, jobID, errMessage, time.Now().UTC(), id)
+	if err != nil {
+		log.Print(nil).Error("Failed to Update WhatsApp Bulk Recipient: " + err.Error())
+		return false
+	}
+
+	return true
+}
+
+// WhatsAppBulkGet Get Bulk Message Progress With Number of Recipients on Every State
+func WhatsAppBulkGet(jid string, id int64) (*Bulk, error) {
+	var bulk Bulk
+	var completedAt sql.NullTime
+
+	err := datastore.DB.QueryRow("SELECT id, type, state, created_at, updated_at, completed_at FROM whatsapp_bulk WHERE jid=$1 AND id=$2", jid, id).
+		Scan(&bulk.ID, &bulk.Type, &bulk.State, &bulk.CreatedAt, &bulk.UpdatedAt, &completedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBulkNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if completedAt.Valid {
+		bulk.CompletedAt = &completedAt.Time
+	}
+
+	rows, err := datastore.DB.Query("SELECT state, COUNT(*) FROM whatsapp_bulk_recipient WHERE bulk_id=$1 GROUP BY state", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bulk.States = make(map[string]int)
+	for rows.Next() {
+		var state string
+		var count int
+
+		err = rows.Scan(&state, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		bulk.States[state] = count
+		bulk.Total += count
+	}
+
+	return &bulk, rows.Err()
+}
+
+// WhatsAppBulkRecipients Get Bulk Message Recipients Result Ordered as Submitted
+// Next Page Can Be Requested Using Next Cursor as After Cursor
+func WhatsAppBulkRecipients(jid string, id int64, query BulkRecipientQuery) (*BulkRecipientPage, error) {
+	var bulkID int64
+
+	err := datastore.DB.QueryRow("SELECT id FROM whatsapp_bulk WHERE jid=$1 AND id=$2", jid, id).Scan(&bulkID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBulkNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if query.Limit <= 0 || query.Limit > 200 {
+		query.Limit = 50
+	}
+
+	sqlQuery := "SELECT id, msisdn, rjid, state, msgid, job_id, error, updated_at FROM whatsapp_bulk_recipient WHERE bulk_id=$1"
+	sqlArgs := []interface{}{id}
+
+	if len(query.State) > 0 {
+		sqlArgs = append(sqlArgs, query.State)
+		sqlQuery += " AND state=$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	if query.After > 0 {
+		sqlArgs = append(sqlArgs, query.After)
+		sqlQuery += " AND id>$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	// Query One More Row to Know if Next Page is Exist
+	sqlArgs = append(sqlArgs, query.Limit+1)
+	sqlQuery += " ORDER BY id LIMIT $" + strconv.Itoa(len(sqlArgs))
+
+	rows, err := datastore.DB.Query(sqlQuery, sqlArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &BulkRecipientPage{
+		Recipients: []BulkRecipient{},
+	}
+
+	for rows.Next() {
+		var recipient BulkRecipient
+
+		err = rows.Scan(&recipient.ID, &recipient.MSISDN, &recipient.JID, &recipient.State, &recipient.MsgID,
+			&recipient.JobID, &recipient.Error, &recipient.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Recipients = append(page.Recipients, recipient)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(page.Recipients) > query.Limit {
+		page.Recipients = page.Recipients[:query.Limit]
+		page.NextCursor = page.Recipients[query.Limit-1].ID
+	}
+
+	return page, nil
+}
@@ -0,0 +1,251 @@
+package whatsapp
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+)
+
+func TestWhatsAppBulkStartInterrupted(t *testing.T) {
+	jid := "bulk-test-interrupted"
+	now := time.Now().UTC()
+
+	// Bulk Message is Already Completed So It Will Not Be Resumed
+	var id int64
+	err := datastore.DB.QueryRow(`INSERT INTO whatsapp_bulk (jid, type, payload, state, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5) RETURNING id`,
+		jid, QueueTypeText, `{"type":"text","text":"Test"}`, BulkStateCompleted, now).Scan(&id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, state := range []string{BulkRecipientStateSending, BulkRecipientStateSent} {
+		_, err = datastore.DB.Exec(`INSERT INTO whatsapp_bulk_recipient (bulk_id, msisdn, vars, rjid, state, msgid, job_id, error, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			id, "6281", "{}", "6281@s.whatsapp.net", state, "", 0, "", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	WhatsAppBulkStart()
+
+	// Recipient Interrupted While Sending Should Not Be Sent Again
+	page, err := WhatsAppBulkRecipients(jid, id, BulkRecipientQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Recipients) != 2 {
+		t.Fatalf("got %d recipient(s), want 2", len(page.Recipients))
+	}
+
+	if recipient := page.Recipients[0]; recipient.State != BulkRecipientStateFailed || recipient.Error != "Interrupted While Sending" {
+		t.Errorf("interrupted recipient state = %s error = %q, want %s", recipient.State, recipient.Error, BulkRecipientStateFailed)
+	}
+
+	if recipient := page.Recipients[1]; recipient.State != BulkRecipientStateSent {
+		t.Errorf("sent recipient state = %s, want %s", recipient.State, BulkRecipientStateSent)
+	}
+}
+
+func bulkTestRunnerExist(id int64) bool {
+	bulkRunners.Lock()
+	defer bulkRunners.Unlock()
+
+	return bulkRunners.data[id] != nil
+}
+
+func bulkTestWaitPaused(t *testing.T, id int64) {
+	deadline := time.Now().Add(5 * time.Second)
+	for bulkTestRunnerExist(id) {
+		if time.Now().After(deadline) {
+			t.Fatal("bulk runner is still running without connected WhatsApp client")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWhatsAppBulkPauseDisconnected(t *testing.T) {
+	jid := "bulk-test-disconnected"
+
+	// Registered WhatsApp Client Without Connection
+	// Same as WhatsApp Client That is Still Reconnecting After Restart
+	client := WhatsAppSession.Create(jid, func() *whatsmeow.Client {
+		return &whatsmeow.Client{}
+	})
+	defer WhatsAppSession.Remove(jid, client)
+
+	bulk, err := WhatsAppBulkCreate(jid, &QueueMessage{Type: QueueTypeText, Text: "Hello {{name}}"}, []BulkRecipientInput{
+		{MSISDN: "6281111111111", Vars: map[string]string{"name": "A"}},
+		{MSISDN: "6281222222222", Vars: map[string]string{"name": "B"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bulkTestWaitPaused(t, bulk.ID)
+
+	// Resumed Runner Should Pause Again While WhatsApp Client is Still Not Connected
+	WhatsAppBulkResume(jid)
+	bulkTestWaitPaused(t, bulk.ID)
+
+	bulk, err = WhatsAppBulkGet(jid, bulk.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bulk.State != BulkStateRunning {
+		t.Errorf("bulk state = %s, want %s", bulk.State, BulkStateRunning)
+	}
+
+	// Recipient Should Be Kept Pending Instead of Failed
+	if bulk.States[BulkRecipientStatePending] != 2 {
+		t.Errorf("recipient states = %v, want 2 %s", bulk.States, BulkRecipientStatePending)
+	}
+}
+
+type bulkTestRecipient struct {
+	state string
+	jobID int64
+}
+
+func bulkTestCreate(t *testing.T, jid string, recipients []bulkTestRecipient) int64 {
+	now := time.Now().UTC()
+
+	var id int64
+	err := datastore.DB.QueryRow(`INSERT INTO whatsapp_bulk (jid, type, payload, state, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5) RETURNING id`,
+		jid, QueueTypeText, `{"type":"text","text":"Test"}`, BulkStateRunning, now).Scan(&id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, recipient := range recipients {
+		_, err = datastore.DB.Exec(`INSERT INTO whatsapp_bulk_recipient (bulk_id, msisdn, vars, rjid, state, msgid, job_id, error, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			id, "6281", "{}", "6281@s.whatsapp.net", recipient.state, "", recipient.jobID, "", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return id
+}
+
+func bulkTestQueueJob(t *testing.T, jid string, state string, errMessage string) int64 {
+	now := time.Now().UTC()
+
+	var jobID int64
+	err := datastore.DB.QueryRow(`INSERT INTO whatsapp_queue (jid, type, chat, payload, state, msgid, error, attempts, run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9, $9) RETURNING id`,
+		jid, QueueTypeText, "6281@s.whatsapp.net", "", state, "", errMessage, 1, now).Scan(&jobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return jobID
+}
+
+func TestWhatsAppBulkQueueDone(t *testing.T) {
+	jid := "bulk-test-queue-done"
+	jobID := bulkTestQueueJob(t, jid, QueueStateSending, "")
+
+	id := bulkTestCreate(t, jid, []bulkTestRecipient{
+		{BulkRecipientStateSent, 0},
+		{BulkRecipientStateQueued, jobID},
+	})
+
+	// Bulk Message Should Not Be Completed While Recipient is Still Queued
+	whatsAppBulkComplete(id)
+
+	bulk, err := WhatsAppBulkGet(jid, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bulk.State != BulkStateRunning {
+		t.Errorf("bulk state = %s, want %s while recipient is queued", bulk.State, BulkStateRunning)
+	}
+
+	whatsAppBulkQueueDone(jobID, QueueStateSent, "MSGID", "")
+
+	bulk, err = WhatsAppBulkGet(jid, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bulk.State != BulkStateCompleted || bulk.CompletedAt == nil {
+		t.Errorf("bulk state = %s, want %s after queue job is sent", bulk.State, BulkStateCompleted)
+	}
+
+	page, err := WhatsAppBulkRecipients(jid, id, BulkRecipientQuery{State: BulkRecipientStateSent})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Recipients) != 2 || page.Recipients[1].MsgID != "MSGID" {
+		t.Errorf("sent recipients = %+v, want queued recipient sent with message id", page.Recipients)
+	}
+}
+
+func TestWhatsAppBulkQueueSync(t *testing.T) {
+	jid := "bulk-test-queue-sync"
+
+	failedJobID := bulkTestQueueJob(t, jid, QueueStateFailed, "Interrupted While Sending")
+	queuedJobID := bulkTestQueueJob(t, jid, QueueStateQueued, "")
+
+	// Job That is Already Removed From Outbound Queue
+	removedJobID := bulkTestQueueJob(t, jid, QueueStateSent, "")
+
+	_, err := datastore.DB.Exec("DELETE FROM whatsapp_queue WHERE id=$1", removedJobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := bulkTestCreate(t, jid, []bulkTestRecipient{
+		{BulkRecipientStateQueued, failedJobID},
+		{BulkRecipientStateQueued, queuedJobID},
+		{BulkRecipientStateQueued, removedJobID},
+	})
+
+	whatsAppBulkQueueSync()
+
+	page, err := WhatsAppBulkRecipients(jid, id, BulkRecipientQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		state string
+		err   string
+	}{
+		{BulkRecipientStateFailed, "Interrupted While Sending"},
+		{BulkRecipientStateQueued, ""},
+		{BulkRecipientStateFailed, "Queue Job is Not Found"},
+	}
+
+	if len(page.Recipients) != len(want) {
+		t.Fatalf("got %d recipient(s), want %d", len(page.Recipients), len(want))
+	}
+
+	for i, recipient := range page.Recipients {
+		if recipient.State != want[i].state || recipient.Error != want[i].err {
+			t.Errorf("recipient %d state = %s error = %q, want %s error = %q", i, recipient.State, recipient.Error, want[i].state, want[i].err)
+		}
+	}
+
+	// Bulk Message is Still Running Since One Recipient is Queued
+	bulk, err := WhatsAppBulkGet(jid, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bulk.State != BulkStateRunning {
+		t.Errorf("bulk state = %s, want %s", bulk.State, BulkStateRunning)
+	}
+}
@@ -0,0 +1,144 @@
+package whatsapp
+
+import (
+	"errors"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+)
+
+type CommunitySubGroup struct {
+	GID       string `json:"gid"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"is_default_subgroup"`
+}
+
+// WhatsAppCommunityCreate Create New Community With Optional Initial Participants
+// Announcement Group of The Community is Created Automatically by WhatsApp
+func WhatsAppCommunityCreate(jid string, name string, participants []string) (*GroupCreateResult, error) {
+	return whatsAppGroupCreate(jid, whatsmeow.ReqCreateGroup{
+		Name:        name,
+		GroupParent: types.GroupParent{IsParent: true},
+	}, participants)
+}
+
+// WhatsAppCommunitySubGroups List Groups Linked to Community Including Its Announcement Group
+func WhatsAppCommunitySubGroups(jid string, cjid string) ([]CommunitySubGroup, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		communityJID, err := whatsAppGroupJID(cjid)
+		if err != nil {
+			return nil, err
+		}
+
+		groups, err := client.GetSubGroups(communityJID)
+		if err != nil {
+			return nil, err
+		}
+
+		subGroups := make([]CommunitySubGroup, len(groups))
+		for i, group := range groups {
+			subGroups[i] = CommunitySubGroup{
+				GID:       group.JID.String(),
+				Name:      group.Name,
+				IsDefault: group.IsDefaultSubGroup,
+			}
+		}
+
+		return subGroups, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppCommunityLink Link Existing Group to Community
+func WhatsAppCommunityLink(jid string, cjid string, gjid string) error {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return err
+		}
+
+		communityJID, groupJID, err := whatsAppCommunityGroupJID(cjid, gjid)
+		if err != nil {
+			return err
+		}
+
+		return client.LinkGroup(communityJID, groupJID)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppCommunityUnlink Unlink Group From Community
+func WhatsAppCommunityUnlink(jid string, cjid string, gjid string) error {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return err
+		}
+
+		communityJID, groupJID, err := whatsAppCommunityGroupJID(cjid, gjid)
+		if err != nil {
+			return err
+		}
+
+		return client.UnlinkGroup(communityJID, groupJID)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppCommunityAnnouncement Get Announcement Group ID of Community
+// Announcement Group is The Default Subgroup Created Together With Community
+func WhatsAppCommunityAnnouncement(jid string, cjid string) (string, error) {
+	subGroups, err := WhatsAppCommunitySubGroups(jid, cjid)
+	if err != nil {
+		return "", err
+	}
+
+	for _, subGroup := range subGroups {
+		if subGroup.IsDefault {
+			return subGroup.GID, nil
+		}
+	}
+
+	return "", errors.New("WhatsApp Community Announcement Group is Not Found")
+}
+
+func whatsAppCommunityGroupJID(cjid string, gjid string) (types.JID, types.JID, error) {
+	communityJID, err := whatsAppGroupJID(cjid)
+	if err != nil {
+		return types.EmptyJID, types.EmptyJID, err
+	}
+
+	groupJID, err := whatsAppGroupJID(gjid)
+	if err != nil {
+		return types.EmptyJID, types.EmptyJID, err
+	}
+
+	if communityJID == groupJID {
+		return types.EmptyJID, types.EmptyJID, errors.New("WhatsApp Group ID Can Not be The Same as Community ID")
+	}
+
+	return communityJID, groupJID, nil
+}
@@ -0,0 +1,339 @@
+package whatsapp
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	waproto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+const (
+	EventTypeMessage      = "message"
+	EventTypeReceipt      = "receipt"
+	EventTypePresence     = "presence"
+	EventTypeConnected    = "connected"
+	EventTypeDisconnected = "disconnected"
+	EventTypeLoggedOut    = "logged_out"
+	EventTypeGroupUpdate  = "group_update"
+	EventTypeGroupJoined  = "group_joined"
+)
+
+type Event struct {
+	ID        string      `json:"id"`
+	JID       string      `json:"jid"`
+	Type      string      `json:"type"`
+	Timestamp time.Time   `json:"timestamp"`
+	Data      interface{} `json:"data,omitempty"`
+}
+
+type EventMessage struct {
+	ID        string    `json:"id"`
+	Chat      string    `json:"chat"`
+	Sender    string    `json:"sender"`
+	PushName  string    `json:"pushname,omitempty"`
+	IsFromMe  bool      `json:"is_from_me"`
+	IsGroup   bool      `json:"is_group"`
+	IsEdit    bool      `json:"is_edit"`
+	Type      string    `json:"type"`
+	Text      string    `json:"text,omitempty"`
+	MimeType  string    `json:"mimetype,omitempty"`
+	FileName  string    `json:"filename,omitempty"`
+	FileSize  uint64    `json:"filesize,omitempty"`
+	Reference string    `json:"reference,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type EventReceipt struct {
+	MsgIDs    []string  `json:"msgids"`
+	Chat      string    `json:"chat"`
+	Sender    string    `json:"sender"`
+	IsGroup   bool      `json:"is_group"`
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type EventPresence struct {
+	From        string     `json:"from"`
+	Unavailable bool       `json:"unavailable"`
+	LastSeen    *time.Time `json:"last_seen,omitempty"`
+}
+
+type EventConnection struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type EventListener func(evt *Event)
+
+var whatsAppEventListeners = struct {
+	sync.RWMutex
+	data []EventListener
+}{}
+
+// WhatsAppAddEventListener Register Listener That Will Receive Every Composed Event
+func WhatsAppAddEventListener(listener EventListener) {
+	whatsAppEventListeners.Lock()
+	defer whatsAppEventListeners.Unlock()
+
+	whatsAppEventListeners.data = append(whatsAppEventListeners.data, listener)
+}
+
+// WhatsAppEmitEvent Send Composed Event to Every Registered Listener
+func WhatsAppEmitEvent(jid string, eventType string, data interface{}) {
+	eventID := make([]byte, 16)
+	_, _ = rand.Read(eventID)
+
+	evt := &Event{
+		ID:        hex.EncodeToString(eventID),
+		JID:       jid,
+		Type:      eventType,
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	}
+
+	whatsAppEventListeners.RLock()
+	listeners := whatsAppEventListeners.data
+	whatsAppEventListeners.RUnlock()
+
+	for _, listener := range listeners {
+		listener(evt)
+	}
+}
+
+// WhatsAppEventHandler Handle Every Event Emitted by WhatsApp Client
+func WhatsAppEventHandler(jid string, evt interface{}) {
+	switch evt := evt.(type) {
+	case *events.Message:
+		msg := WhatsAppComposeEventMessage(evt)
+
+		whatsAppPollHandleMessage(jid, evt)
+		whatsAppHistoryHandleMessage(jid, evt, msg)
+
+		WhatsAppEmitEvent(jid, EventTypeMessage, msg)
+
+	case *events.Receipt:
+		whatsAppReceiptHandle(jid, evt)
+
+		WhatsAppEmitEvent(jid, EventTypeReceipt, &EventReceipt{
+			MsgIDs:    evt.MessageIDs,
+			Chat:      evt.Chat.String(),
+			Sender:    evt.Sender.ToNonAD().String(),
+			IsGroup:   evt.IsGroup,
+			Status:    WhatsAppReceiptStatus(evt.Type),
+			Timestamp: evt.Timestamp,
+		})
+
+	case *events.Presence:
+		presence := &EventPresence{
+			From:        evt.From.String(),
+			Unavailable: evt.Unavailable,
+		}
+
+		if !evt.LastSeen.IsZero() {
+			presence.LastSeen = &evt.LastSeen
+		}
+
+		WhatsAppEmitEvent(jid, EventTypePresence, presence)
+
+	case *events.GroupInfo:
+		group := WhatsAppComposeEventGroup(evt)
+
+		whatsAppGroupHistoryHandle(jid, group)
+
+		WhatsAppEmitEvent(jid, EventTypeGroupUpdate, group)
+
+	case *events.JoinedGroup:
+		group := WhatsAppComposeEventJoinedGroup(jid, evt)
+
+		whatsAppGroupHistoryHandle(jid, group)
+
+		WhatsAppEmitEvent(jid, EventTypeGroupJoined, group)
+
+	case *events.Connected:
+		WhatsAppSession.SetConnected(jid)
+		WhatsAppEmitEvent(jid, EventTypeConnected, nil)
+
+		// Resume Outbound Queue Job Postponed While Disconnected
+		WhatsAppQueueResume(jid)
+
+		// Resume Bulk Message Paused While Disconnected
+		WhatsAppBulkResume(jid)
+
+	case *events.Disconnected:
+		WhatsAppSession.SetDisconnected(jid, "disconnected")
+		WhatsAppEmitEvent(jid, EventTypeDisconnected, nil)
+
+	case *events.StreamReplaced:
+		WhatsAppSession.SetDisconnected(jid, "stream_replaced")
+
+	case *events.StreamError:
+		WhatsAppSession.SetDisconnected(jid, "stream_error: "+evt.Code)
+
+	case *events.ClientOutdated:
+		WhatsAppSession.SetDisconnected(jid, "client_outdated")
+
+	case *events.TemporaryBan:
+		WhatsAppSession.SetDisconnected(jid, "temporary_ban: "+evt.String())
+
+	case *events.ConnectFailure:
+		WhatsAppSession.SetDisconnected(jid, "connect_failure: "+evt.Reason.String())
+
+	case *events.LoggedOut:
+		WhatsAppSession.SetDisconnected(jid, "logged_out: "+evt.Reason.String())
+		WhatsAppQueueStop(jid)
+		WhatsAppEmitEvent(jid, EventTypeLoggedOut, &EventConnection{
+			Reason: evt.Reason.String(),
+		})
+	}
+}
+
+// WhatsAppReceiptStatus Convert WhatsApp Receipt Type to Stable Status Name
+func WhatsAppReceiptStatus(receiptType types.ReceiptType) string {
+	switch receiptType {
+	case types.ReceiptTypeDelivered:
+		return "delivered"
+	case types.ReceiptTypeSender:
+		return "sender"
+	case types.ReceiptTypeRead, types.ReceiptTypeReadSelf:
+		return "read"
+	case types.ReceiptTypePlayed, types.ReceiptTypePlayedSelf:
+		return "played"
+	case types.ReceiptTypeServerError:
+		return "server_error"
+	case types.ReceiptTypeRetry:
+		return "retry"
+	default:
+		return string(receiptType)
+	}
+}
+
+// WhatsAppComposeEventMessage Convert Received Message to Stable Message Schema
+func WhatsAppComposeEventMessage(evt *events.Message) *EventMessage {
+	msg := &EventMessage{
+		ID:        evt.Info.ID,
+		Chat:      evt.Info.Chat.String(),
+		Sender:    evt.Info.Sender.ToNonAD().String(),
+		PushName:  evt.Info.PushName,
+		IsFromMe:  evt.Info.IsFromMe,
+		IsGroup:   evt.Info.IsGroup,
+		IsEdit:    evt.IsEdit,
+		Timestamp: evt.Info.Timestamp,
+	}
+
+	WhatsAppMessageContent(evt.Message, msg)
+
+	return msg
+}
+
+// WhatsAppMessageContent Fill Message Type, Text and Media Information From Message Proto
+func WhatsAppMessageContent(message *waproto.Message, msg *EventMessage) {
+	// Unwrap Edited Message Sent by This WhatsApp Client
+	if message.GetEditedMessage().GetMessage() != nil {
+		message = message.GetEditedMessage().GetMessage()
+	}
+
+	switch {
+	case message.GetConversation() != "":
+		msg.Type = "text"
+		msg.Text = message.GetConversation()
+
+	case message.GetExtendedTextMessage() != nil:
+		msg.Type = "text"
+		msg.Text = message.GetExtendedTextMessage().GetText()
+		msg.Reference = message.GetExtendedTextMessage().GetContextInfo().GetStanzaID()
+
+	case message.GetImageMessage() != nil:
+		media := message.GetImageMessage()
+		msg.Type = "image"
+		msg.Text = media.GetCaption()
+		msg.MimeType = media.GetMimetype()
+		msg.FileSize = media.GetFileLength()
+
+	case message.GetVideoMessage() != nil:
+		media := message.GetVideoMessage()
+		msg.Type = "video"
+		msg.Text = media.GetCaption()
+		msg.MimeType = media.GetMimetype()
+		msg.FileSize = media.GetFileLength()
+
+	case message.GetAudioMessage() != nil:
+		media := message.GetAudioMessage()
+		msg.Type = "audio"
+		msg.MimeType = media.GetMimetype()
+		msg.FileSize = media.GetFileLength()
+
+	case message.GetDocumentMessage() != nil:
+		media := message.GetDocumentMessage()
+		msg.Type = "document"
+		msg.Text = media.GetCaption()
+		msg.MimeType = media.GetMimetype()
+		msg.FileName = media.GetFileName()
+		msg.FileSize = media.GetFileLength()
+
+	case message.GetStickerMessage() != nil:
+		media := message.GetStickerMessage()
+		msg.Type = "sticker"
+		msg.MimeType = media.GetMimetype()
+		msg.FileSize = media.GetFileLength()
+
+	case message.GetLocationMessage() != nil:
+		msg.Type = "location"
+		msg.Text = message.GetLocationMessage().GetName()
+
+	case message.GetContactMessage() != nil:
+		msg.Type = "contact"
+		msg.Text = message.GetContactMessage().GetDisplayName()
+
+	case message.GetContactsArrayMessage() != nil:
+		msg.Type = "contact"
+		msg.Text = message.GetContactsArrayMessage().GetDisplayName()
+
+	case message.GetPollCreationMessage() != nil || message.GetPollCreationMessageV2() != nil || message.GetPollCreationMessageV3() != nil:
+		msg.Type = "poll"
+		msg.Text = message.GetPollCreationMessage().GetName() +
+			message.GetPollCreationMessageV2().GetName() +
+			message.GetPollCreationMessageV3().GetName()
+
+	case message.GetPollUpdateMessage() != nil:
+		msg.Type = "poll_update"
+		msg.Reference = message.GetPollUpdateMessage().GetPollCreationMessageKey().GetID()
+
+	case message.GetReactionMessage() != nil:
+		msg.Type = "reaction"
+		msg.Text = message.GetReactionMessage().GetText()
+		msg.Reference = message.GetReactionMessage().GetKey().GetID()
+
+	case message.GetProtocolMessage().GetType() == waproto.ProtocolMessage_REVOKE:
+		msg.Type = "revoke"
+		msg.Reference = message.GetProtocolMessage().GetKey().GetID()
+
+	case message.GetProtocolMessage().GetType() == waproto.ProtocolMessage_MESSAGE_EDIT:
+		editedMessage := message.GetProtocolMessage().GetEditedMessage()
+		msg.Type = "edit"
+		msg.Text = editedMessage.GetConversation() + editedMessage.GetExtendedTextMessage().GetText()
+		msg.Reference = message.GetProtocolMessage().GetKey().GetID()
+
+	default:
+		msg.Type = "unknown"
+	}
+}
+
+// WhatsAppEventChat Get Chat JID Related to Event
+// Return Empty String When Event is Not Related to Any Chat
+func WhatsAppEventChat(evt *Event) string {
+	switch data := evt.Data.(type) {
+	case *EventMessage:
+		return data.Chat
+	case *EventReceipt:
+		return data.Chat
+	case *EventPresence:
+		return data.From
+	case *EventGroup:
+		return data.Chat
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,839 @@
+package whatsapp
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sunshineplan/imgconv"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+)
+
+const (
+	GroupParticipantAdd     = string(whatsmeow.ParticipantChangeAdd)
+	GroupParticipantRemove  = string(whatsmeow.ParticipantChangeRemove)
+	GroupParticipantPromote = string(whatsmeow.ParticipantChangePromote)
+	GroupParticipantDemote  = string(whatsmeow.ParticipantChangeDemote)
+)
+
+const (
+	GroupRequestApprove = string(whatsmeow.ParticipantChangeApprove)
+	GroupRequestReject  = string(whatsmeow.ParticipantChangeReject)
+)
+
+type GroupParticipantResult struct {
+	MSISDN           string     `json:"msisdn"`
+	JID              string     `json:"jid,omitempty"`
+	Code             int        `json:"code"`
+	Description      string     `json:"description"`
+	InviteCode       string     `json:"invite_code,omitempty"`
+	InviteExpiration *time.Time `json:"invite_expiration,omitempty"`
+}
+
+type GroupCreateResult struct {
+	GID          string                   `json:"gid"`
+	Name         string                   `json:"name"`
+	Participants []GroupParticipantResult `json:"participants"`
+}
+
+type GroupPreview struct {
+	GID        string    `json:"gid"`
+	Name       string    `json:"name"`
+	Topic      string    `json:"topic,omitempty"`
+	Owner      string    `json:"owner,omitempty"`
+	Size       int       `json:"size"`
+	IsAnnounce bool      `json:"is_announce"`
+	IsLocked   bool      `json:"is_locked"`
+	IsParent   bool      `json:"is_parent"`
+	Parent     string    `json:"parent,omitempty"`
+	IsDefault  bool      `json:"is_default_subgroup"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type GroupSummary struct {
+	GroupPreview
+	IsAdmin bool `json:"is_admin"`
+}
+
+type GroupQuery struct {
+	Query   string
+	IsAdmin bool
+	Parent  string
+	After   string
+	Limit   int
+}
+
+type GroupPage struct {
+	Groups     []GroupSummary `json:"groups"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+type GroupParticipantInfo struct {
+	JID          string `json:"jid"`
+	LID          string `json:"lid,omitempty"`
+	IsAdmin      bool   `json:"is_admin"`
+	IsSuperAdmin bool   `json:"is_super_admin"`
+	DisplayName  string `json:"display_name,omitempty"`
+}
+
+type GroupDetail struct {
+	GroupPreview
+	IsAdmin           bool                   `json:"is_admin"`
+	IsIncognito       bool                   `json:"is_incognito"`
+	DisappearingTimer uint32                 `json:"disappearing_timer"`
+	MemberAddMode     string                 `json:"member_add_mode,omitempty"`
+	JoinApprovalMode  string                 `json:"join_approval_mode,omitempty"`
+	Participants      []GroupParticipantInfo `json:"participants"`
+}
+
+type GroupJoinRequest struct {
+	JID string `json:"jid"`
+}
+
+// Group Profile Photo Should be Square JPEG
+// WhatsApp Client Itself Upload 640px Photo
+const groupPhotoSize = 640
+
+// WhatsAppGroupParseDisappearing Parse Disappearing Message Timer
+// Only Timer Accepted by WhatsApp Are Allowed, Which is Off, 24h, 7d and 90d
+func WhatsAppGroupParseDisappearing(timer string) (time.Duration, error) {
+	switch strings.ToLower(strings.TrimSpace(timer)) {
+	case "off", "0":
+		return whatsmeow.DisappearingTimerOff, nil
+
+	case "24h", "1d", "86400":
+		return whatsmeow.DisappearingTimer24Hours, nil
+
+	case "7d", "604800":
+		return whatsmeow.DisappearingTimer7Days, nil
+
+	case "90d", "7776000":
+		return whatsmeow.DisappearingTimer90Days, nil
+	}
+
+	return 0, errors.New("Disappearing Timer Should be One of off, 24h, 7d or 90d")
+}
+
+// WhatsAppGroupCreate Create New Group With Initial Participants
+// Group is Created Inside Community When Parent Group ID is Given
+// Participant That Can Not be Added is Reported With Its Result Code
+func WhatsAppGroupCreate(jid string, name string, participants []string, parent string) (*GroupCreateResult, error) {
+	reqCreateGroup := whatsmeow.ReqCreateGroup{
+		Name: name,
+	}
+
+	if len(parent) > 0 {
+		parentJID, err := whatsAppGroupJID(parent)
+		if err != nil {
+			return nil, err
+		}
+
+		reqCreateGroup.LinkedParentJID = parentJID
+	}
+
+	return whatsAppGroupCreate(jid, reqCreateGroup, participants)
+}
+
+func whatsAppGroupCreate(jid string, reqCreateGroup whatsmeow.ReqCreateGroup, participants []string) (*GroupCreateResult, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		var results []GroupParticipantResult
+		if len(participants) > 0 {
+			reqCreateGroup.Participants, results, err = whatsAppGroupParticipantJIDs(jid, participants)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		group, err := client.CreateGroup(reqCreateGroup)
+		if err != nil {
+			return nil, err
+		}
+
+		// Own JID is Added Implicitly by WhatsApp
+		// So Only Participant From Request is Reported
+		return &GroupCreateResult{
+			GID:          group.JID.String(),
+			Name:         group.Name,
+			Participants: whatsAppGroupParticipantResults(results, group.Participants),
+		}, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupParticipants Add, Remove, Promote or Demote Group Participants
+// Every Participant Has Its Own Result Code Since Each of Them Can Fail Independently
+func WhatsAppGroupParticipants(jid string, gjid string, participants []string, action string) ([]GroupParticipantResult, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		switch action {
+		case GroupParticipantAdd, GroupParticipantRemove, GroupParticipantPromote, GroupParticipantDemote:
+		default:
+			return nil, errors.New("Group Participant Action Should be One of add, remove, promote or demote")
+		}
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return nil, err
+		}
+
+		participantJIDs, results, err := whatsAppGroupParticipantJIDs(jid, participants)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(participantJIDs) == 0 {
+			return results, nil
+		}
+
+		changes, err := client.UpdateGroupParticipants(groupJID, participantJIDs, whatsmeow.ParticipantChange(action))
+		if err != nil {
+			return nil, err
+		}
+
+		return whatsAppGroupParticipantResults(results, changes), nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupSetName Change Group Subject
+func WhatsAppGroupSetName(jid string, gjid string, name string) error {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return err
+		}
+
+		return client.SetGroupName(groupJID, name)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupSetTopic Change Group Description, Empty Description Removes It
+func WhatsAppGroupSetTopic(jid string, gjid string, topic string) error {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return err
+		}
+
+		// Previous Description ID is Fetched by WhatsMeow From Group Information
+		return client.SetGroupTopic(groupJID, "", "", topic)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupSetPhoto Change Group Photo, Empty Photo Bytes Removes It
+// Photo is Cropped to Square and Converted to JPEG Before Uploaded
+func WhatsAppGroupSetPhoto(jid string, gjid string, photoBytes []byte) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return "", err
+		}
+
+		var avatarBytes []byte
+		if len(photoBytes) > 0 {
+			avatarBytes, err = whatsAppGroupPhoto(photoBytes)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		return client.SetGroupPhoto(groupJID, avatarBytes)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupSetAnnounce Toggle Announce Mode, Only Admins Can Send Message When Enabled
+func WhatsAppGroupSetAnnounce(jid string, gjid string, isAnnounce bool) error {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return err
+		}
+
+		return client.SetGroupAnnounce(groupJID, isAnnounce)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupSetLocked Toggle Locked Mode, Only Admins Can Edit Group Information When Enabled
+func WhatsAppGroupSetLocked(jid string, gjid string, isLocked bool) error {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return err
+		}
+
+		return client.SetGroupLocked(groupJID, isLocked)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupSetDisappearing Set Group Disappearing Message Timer, Zero Timer Disables It
+func WhatsAppGroupSetDisappearing(jid string, gjid string, timer time.Duration) error {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return err
+		}
+
+		return client.SetDisappearingTimer(groupJID, timer)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupInvitePreview Get Group Information From Invitation Link Without Joining
+func WhatsAppGroupInvitePreview(jid string, link string) (*GroupPreview, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		group, err := client.GetGroupInfoFromLink(link)
+		if err != nil {
+			return nil, err
+		}
+
+		return whatsAppGroupPreview(group), nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupInviteMessagePreview Get Group Information From Direct Invitation Message Without Joining
+func WhatsAppGroupInviteMessagePreview(jid string, gjid string, inviter string, code string, expiration int64) (*GroupPreview, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		groupJID, inviterJID, err := whatsAppGroupInvite(gjid, inviter, expiration)
+		if err != nil {
+			return nil, err
+		}
+
+		group, err := client.GetGroupInfoFromInvite(groupJID, inviterJID, code, expiration)
+		if err != nil {
+			return nil, err
+		}
+
+		return whatsAppGroupPreview(group), nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupJoinInvite Join Group Using Direct Invitation Message
+// Invitation Message Contains Group ID, Invite Code and Expiration Instead of Link
+func WhatsAppGroupJoinInvite(jid string, gjid string, inviter string, code string, expiration int64) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		groupJID, inviterJID, err := whatsAppGroupInvite(gjid, inviter, expiration)
+		if err != nil {
+			return "", err
+		}
+
+		err = client.JoinGroupWithInvite(groupJID, inviterJID, code, expiration)
+		if err != nil {
+			return "", err
+		}
+
+		// Return Joined Group ID
+		return groupJID.String(), nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupInviteLink Get Group Invitation Link, Reset Revokes Old Link and Generate New One
+// Only Group Admin is Allowed to Get Invitation Link
+func WhatsAppGroupInviteLink(jid string, gjid string, isReset bool) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return "", err
+		}
+
+		return client.GetGroupInviteLink(groupJID, isReset)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupInfo Get Single Group Information Including Participants
+// Participant LID is Filled When WhatsApp Provides The Mapping
+func WhatsAppGroupInfo(jid string, gjid string) (*GroupDetail, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return nil, err
+		}
+
+		group, err := client.GetGroupInfo(groupJID)
+		if err != nil {
+			return nil, err
+		}
+
+		detail := &GroupDetail{
+			GroupPreview:      *whatsAppGroupPreview(group),
+			IsAdmin:           whatsAppGroupIsAdmin(client, group),
+			IsIncognito:       group.IsIncognito,
+			DisappearingTimer: group.DisappearingTimer,
+			MemberAddMode:     string(group.MemberAddMode),
+			JoinApprovalMode:  group.DefaultMembershipApprovalMode,
+			Participants:      make([]GroupParticipantInfo, len(group.Participants)),
+		}
+
+		for i, participant := range group.Participants {
+			detail.Participants[i] = GroupParticipantInfo{
+				JID:          participant.JID.String(),
+				IsAdmin:      participant.IsAdmin,
+				IsSuperAdmin: participant.IsSuperAdmin,
+				DisplayName:  participant.DisplayName,
+			}
+
+			if !participant.LID.IsEmpty() {
+				detail.Participants[i].LID = participant.LID.String()
+			}
+		}
+
+		return detail, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupRequests Get Pending Join Requests of Group in Membership Approval Mode
+func WhatsAppGroupRequests(jid string, gjid string) ([]GroupJoinRequest, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return nil, err
+		}
+
+		requesters, err := client.GetGroupRequestParticipants(groupJID)
+		if err != nil {
+			return nil, err
+		}
+
+		requests := make([]GroupJoinRequest, len(requesters))
+		for i, requester := range requesters {
+			requests[i].JID = requester.String()
+		}
+
+		return requests, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupRequestsUpdate Approve or Reject Pending Join Requests
+// Requester is Used as is Without Registered Check Since It Comes From Join Request List
+func WhatsAppGroupRequestsUpdate(jid string, gjid string, requesters []string, action string) ([]GroupParticipantResult, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		switch action {
+		case GroupRequestApprove, GroupRequestReject:
+		default:
+			return nil, errors.New("Group Request Action Should be One of approve or reject")
+		}
+
+		if len(requesters) == 0 {
+			return nil, errors.New("Group Requesters Should Not be Empty")
+		}
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		groupJID, err := whatsAppGroupJID(gjid)
+		if err != nil {
+			return nil, err
+		}
+
+		requesterJIDs := make([]types.JID, len(requesters))
+		results := make([]GroupParticipantResult, len(requesters))
+
+		for i, requester := range requesters {
+			// Requester Can be LID So Full JID is Kept When Given
+			requesterJID, err := types.ParseJID(requester)
+			if err != nil || !strings.ContainsRune(requester, '@') {
+				requesterJID = WhatsAppComposeJID(requester)
+			}
+
+			requesterJIDs[i] = requesterJID
+
+			results[i].MSISDN = requester
+			results[i].JID = requesterJID.String()
+		}
+
+		changes, err := client.UpdateGroupRequestParticipants(groupJID, requesterJIDs, whatsmeow.ParticipantRequestChange(action))
+		if err != nil {
+			return nil, err
+		}
+
+		return whatsAppGroupParticipantResults(results, changes), nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+func whatsAppGroupJID(gjid string) (types.JID, error) {
+	groupJID := WhatsAppComposeJID(gjid)
+
+	// Make Sure WhatsApp ID is Group Server
+	if groupJID.Server != types.GroupServer {
+		return types.EmptyJID, errors.New("WhatsApp Group ID is Not Group Server")
+	}
+
+	return groupJID, nil
+}
+
+func whatsAppGroupInvite(gjid string, inviter string, expiration int64) (types.JID, types.JID, error) {
+	groupJID, err := whatsAppGroupJID(gjid)
+	if err != nil {
+		return types.EmptyJID, types.EmptyJID, err
+	}
+
+	// Invitation Message Expiration is in Unix Timestamp Seconds
+	if expiration > 0 && time.Unix(expiration, 0).Before(time.Now()) {
+		return types.EmptyJID, types.EmptyJID, errors.New("Group Invitation is Expired")
+	}
+
+	inviterJID := WhatsAppComposeJID(inviter)
+	if inviterJID.Server != types.DefaultUserServer {
+		return types.EmptyJID, types.EmptyJID, errors.New("WhatsApp Inviter ID is Not Personal ID")
+	}
+
+	return groupJID, inviterJID, nil
+}
+
+func whatsAppGroupIsAdmin(client *whatsmeow.Client, group *types.GroupInfo) bool {
+	if client.Store.ID == nil {
+		return false
+	}
+
+	for _, participant := range group.Participants {
+		if participant.JID.User == client.Store.ID.User {
+			return participant.IsAdmin || participant.IsSuperAdmin
+		}
+	}
+
+	return false
+}
+
+// Group Size is Counted From Participants Returned by WhatsApp
+func whatsAppGroupPreview(group *types.GroupInfo) *GroupPreview {
+	preview := &GroupPreview{
+		GID:        group.JID.String(),
+		Name:       group.Name,
+		Topic:      group.Topic,
+		Size:       len(group.Participants),
+		IsAnnounce: group.IsAnnounce,
+		IsLocked:   group.IsLocked,
+		IsParent:   group.IsParent,
+		IsDefault:  group.IsDefaultSubGroup,
+		CreatedAt:  group.GroupCreated,
+	}
+
+	if !group.OwnerJID.IsEmpty() {
+		preview.Owner = group.OwnerJID.String()
+	}
+
+	// Group Linked to Community Has Its Community as Parent
+	if !group.LinkedParentJID.IsEmpty() {
+		preview.Parent = group.LinkedParentJID.String()
+	}
+
+	return preview
+}
+
+// Resolve Participants to Their Canonical JID Using Single Registered Check
+// Participant That is Not Registered Already Has Its Result and is Not Sent to WhatsApp
+func whatsAppGroupParticipantJIDs(jid string, participants []string) ([]types.JID, []GroupParticipantResult, error) {
+	if len(participants) == 0 {
+		return nil, nil, errors.New("Group Participants Should Not be Empty")
+	}
+
+	phones := make([]string, len(participants))
+	for i, participant := range participants {
+		phones[i] = WhatsAppDecomposeJID(strings.TrimSpace(participant))
+	}
+
+	registered, err := WhatsAppRegisteredCheck(jid, phones)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var participantJIDs []types.JID
+	results := make([]GroupParticipantResult, len(participants))
+
+	for i, phone := range phones {
+		results[i].MSISDN = participants[i]
+
+		participantJID := whatsAppRegisteredJID(registered[phone])
+		if participantJID.IsEmpty() {
+			results[i].Code = 404
+			results[i].Description = whatsAppGroupParticipantDescription(404)
+			continue
+		}
+
+		results[i].JID = participantJID.String()
+		participantJIDs = append(participantJIDs, participantJID)
+	}
+
+	return participantJIDs, results, nil
+}
+
+// Merge WhatsApp Participant Response to Result by Matching The JID User
+// Participant Missing From Response is Assumed to be Successful
+func whatsAppGroupParticipantResults(results []GroupParticipantResult, participants []types.GroupParticipant) []GroupParticipantResult {
+	responses := make(map[string]types.GroupParticipant)
+	for _, participant := range participants {
+		responses[participant.JID.User] = participant
+	}
+
+	for i := range results {
+		if results[i].Code != 0 {
+			continue
+		}
+
+		results[i].Code = 200
+
+		participantJID, err := types.ParseJID(results[i].JID)
+		if err != nil {
+			continue
+		}
+
+		if participant, isExist := responses[participantJID.User]; isExist {
+			if participant.Error != 0 {
+				results[i].Code = participant.Error
+			}
+
+			// Participant With Privacy Settings Can Only be Invited
+			if participant.AddRequest != nil {
+				expiration := participant.AddRequest.Expiration
+
+				results[i].InviteCode = participant.AddRequest.Code
+				results[i].InviteExpiration = &expiration
+			}
+		}
+
+		results[i].Description = whatsAppGroupParticipantDescription(results[i].Code)
+	}
+
+	return results
+}
+
+func whatsAppGroupParticipantDescription(code int) string {
+	switch code {
+	case 200:
+		return "Success"
+
+	case 403:
+		return "Participant Privacy Settings Only Allow Invitation"
+
+	case 404:
+		return "WhatsApp Personal ID is Not Registered"
+
+	case 406:
+		return "Participant Not Acceptable"
+
+	case 408:
+		return "Participant Recently Left The Group"
+
+	case 409:
+		return "Participant Already in The Group"
+
+	case 500:
+		return "Group is Full"
+	}
+
+	return "Failed With Code " + strconv.Itoa(code)
+}
+
+// Crop Photo From The Center to Square Then Encode it as JPEG
+func whatsAppGroupPhoto(photoBytes []byte) ([]byte, error) {
+	photoDecode, err := imgconv.Decode(bytes.NewReader(photoBytes))
+	if err != nil {
+		return nil, errors.New("Error While Decoding Group Photo Stream")
+	}
+
+	bounds := photoDecode.Bounds()
+
+	size := bounds.Dx()
+	if bounds.Dy() < size {
+		size = bounds.Dy()
+	}
+
+	if subImage, isSubImage := photoDecode.(interface {
+		SubImage(r image.Rectangle) image.Image
+	}); isSubImage {
+		x := bounds.Min.X + (bounds.Dx()-size)/2
+		y := bounds.Min.Y + (bounds.Dy()-size)/2
+
+		photoDecode = subImage.SubImage(image.Rect(x, y, x+size, y+size))
+	}
+
+	if size > groupPhotoSize {
+		photoDecode = imgconv.Resize(photoDecode, &imgconv.ResizeOption{Width: groupPhotoSize, Height: groupPhotoSize})
+	}
+
+	photoEncode := new(bytes.Buffer)
+
+	err = imgconv.Write(photoEncode, photoDecode, &imgconv.FormatOption{Format: imgconv.JPEG})
+	if err != nil {
+		return nil, errors.New("Error While Encoding Group Photo Stream")
+	}
+
+	return photoEncode.Bytes(), nil
+}
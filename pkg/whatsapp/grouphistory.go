@@ -0,0 +1,334 @@
+package whatsapp
+
+import (
+	"strconv"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+)
+
+const (
+	GroupHistoryJoined       = "joined"
+	GroupHistoryJoin         = "join"
+	GroupHistoryAdd          = "add"
+	GroupHistoryLeave        = "leave"
+	GroupHistoryRemove       = "remove"
+	GroupHistoryPromote      = "promote"
+	GroupHistoryDemote       = "demote"
+	GroupHistorySubject      = "subject"
+	GroupHistoryDescription  = "description"
+	GroupHistoryLocked       = "locked"
+	GroupHistoryAnnounce     = "announce"
+	GroupHistoryDisappearing = "disappearing"
+	GroupHistoryInviteLink   = "invite_link"
+	GroupHistoryLink         = "link"
+	GroupHistoryUnlink       = "unlink"
+	GroupHistoryDelete       = "delete"
+)
+
+type EventGroupChange struct {
+	Action      string `json:"action"`
+	Participant string `json:"participant,omitempty"`
+	Value       string `json:"value,omitempty"`
+}
+
+type EventGroup struct {
+	Chat      string             `json:"chat"`
+	Actor     string             `json:"actor,omitempty"`
+	Changes   []EventGroupChange `json:"changes"`
+	Timestamp time.Time          `json:"timestamp"`
+}
+
+type GroupHistoryEntry struct {
+	Cursor    int64     `json:"cursor"`
+	Chat      string    `json:"chat"`
+	Actor     string    `json:"actor,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	EventGroupChange
+}
+
+type GroupHistoryQuery struct {
+	Action string
+	Before int64
+	Limit  int
+}
+
+type GroupHistoryPage struct {
+	Entries    []GroupHistoryEntry `json:"entries"`
+	NextCursor int64               `json:"next_cursor,omitempty"`
+}
+
+var whatsAppGroupHistoryMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE whatsapp_group_history (
+				id          INTEGER PRIMARY KEY AUTOINCREMENT,
+				jid         TEXT NOT NULL,
+				chat        TEXT NOT NULL,
+				action      TEXT NOT NULL,
+				participant TEXT NOT NULL,
+				actor       TEXT NOT NULL,
+				value       TEXT NOT NULL,
+				timestamp   TIMESTAMP NOT NULL
+			)`,
+			`CREATE INDEX whatsapp_group_history_chat_idx ON whatsapp_group_history (jid, chat, id)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE whatsapp_group_history (
+				id          BIGSERIAL PRIMARY KEY,
+				jid         TEXT NOT NULL,
+				chat        TEXT NOT NULL,
+				action      TEXT NOT NULL,
+				participant TEXT NOT NULL,
+				actor       TEXT NOT NULL,
+				value       TEXT NOT NULL,
+				timestamp   TIMESTAMPTZ NOT NULL
+			)`,
+			`CREATE INDEX whatsapp_group_history_chat_idx ON whatsapp_group_history (jid, chat, id)`,
+		},
+	},
+}
+
+func init() {
+	datastore.Register("whatsapp_group_history", whatsAppGroupHistoryMigrations)
+}
+
+// WhatsAppComposeEventGroup Convert Group Information Change to Stable Group Event Schema
+// Participant Joined or Left by Other Actor is Recorded as Added or Removed
+func WhatsAppComposeEventGroup(evt *events.GroupInfo) *EventGroup {
+	group := &EventGroup{
+		Chat:      evt.JID.String(),
+		Changes:   []EventGroupChange{},
+		Timestamp: evt.Timestamp,
+	}
+
+	if group.Timestamp.IsZero() {
+		group.Timestamp = time.Now().UTC()
+	}
+
+	var actor types.JID
+	if evt.Sender != nil {
+		actor = evt.Sender.ToNonAD()
+		group.Actor = actor.String()
+	}
+
+	participantChanges := func(participants []types.JID, action string, actorAction string) {
+		for _, participant := range participants {
+			participant = participant.ToNonAD()
+
+			change := EventGroupChange{
+				Action:      action,
+				Participant: participant.String(),
+			}
+
+			if len(actorAction) > 0 && !actor.IsEmpty() && actor.User != participant.User {
+				change.Action = actorAction
+			}
+
+			group.Changes = append(group.Changes, change)
+		}
+	}
+
+	participantChanges(evt.Join, GroupHistoryJoin, GroupHistoryAdd)
+	participantChanges(evt.Leave, GroupHistoryLeave, GroupHistoryRemove)
+	participantChanges(evt.Promote, GroupHistoryPromote, "")
+	participantChanges(evt.Demote, GroupHistoryDemote, "")
+
+	if evt.Name != nil {
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistorySubject,
+			Value:  evt.Name.Name,
+		})
+	}
+
+	if evt.Topic != nil {
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistoryDescription,
+			Value:  evt.Topic.Topic,
+		})
+	}
+
+	if evt.Locked != nil {
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistoryLocked,
+			Value:  strconv.FormatBool(evt.Locked.IsLocked),
+		})
+	}
+
+	if evt.Announce != nil {
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistoryAnnounce,
+			Value:  strconv.FormatBool(evt.Announce.IsAnnounce),
+		})
+	}
+
+	// Disappearing Timer is Recorded in Seconds, Zero Means Disabled
+	if evt.Ephemeral != nil {
+		var timer uint32
+		if evt.Ephemeral.IsEphemeral {
+			timer = evt.Ephemeral.DisappearingTimer
+		}
+
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistoryDisappearing,
+			Value:  strconv.FormatUint(uint64(timer), 10),
+		})
+	}
+
+	// Invitation Link Itself is Not Recorded Since Anyone With It Can Join
+	if evt.NewInviteLink != nil {
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistoryInviteLink,
+		})
+	}
+
+	if evt.Link != nil {
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistoryLink,
+			Value:  evt.Link.Group.JID.String(),
+		})
+	}
+
+	if evt.Unlink != nil {
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistoryUnlink,
+			Value:  evt.Unlink.Group.JID.String(),
+		})
+	}
+
+	if evt.Delete != nil && evt.Delete.Deleted {
+		group.Changes = append(group.Changes, EventGroupChange{
+			Action: GroupHistoryDelete,
+			Value:  evt.Delete.DeleteReason,
+		})
+	}
+
+	return group
+}
+
+// WhatsAppComposeEventJoinedGroup Convert Joined Group to Group Event Schema
+// Value is The Join Reason, Which is "invite" When Joining Using Invitation Link or "new" When Creating Group
+func WhatsAppComposeEventJoinedGroup(jid string, evt *events.JoinedGroup) *EventGroup {
+	change := EventGroupChange{
+		Action: GroupHistoryJoined,
+		Value:  evt.Reason,
+	}
+
+	if len(change.Value) == 0 {
+		change.Value = evt.Type
+	}
+
+	if client := WhatsAppSession.Get(jid); client != nil && client.Store.ID != nil {
+		change.Participant = client.Store.ID.ToNonAD().String()
+	}
+
+	return &EventGroup{
+		Chat:      evt.JID.String(),
+		Changes:   []EventGroupChange{change},
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// WhatsAppGroupHistorySave Save Every Change of Group Event as Group History Entry
+func WhatsAppGroupHistorySave(jid string, group *EventGroup) error {
+	timestamp := group.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	tx, err := datastore.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, change := range group.Changes {
+		_, err = tx.Exec(`INSERT INTO whatsapp_group_history (jid, chat, action, participant, actor, value, timestamp)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			jid, group.Chat, change.Action, change.Participant, group.Actor, change.Value, timestamp.UTC())
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func whatsAppGroupHistoryHandle(jid string, group *EventGroup) {
+	if len(group.Changes) == 0 {
+		return
+	}
+
+	err := WhatsAppGroupHistorySave(jid, group)
+	if err != nil {
+		log.Print(nil).Error("Failed to Save WhatsApp Group History: " + err.Error())
+	}
+}
+
+// WhatsAppGroupHistory Get Group History Ordered From The Newest Entry
+// Next Page Can Be Requested Using Next Cursor as Before Cursor
+func WhatsAppGroupHistory(jid string, gjid string, query GroupHistoryQuery) (*GroupHistoryPage, error) {
+	groupJID, err := whatsAppGroupJID(gjid)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Limit <= 0 || query.Limit > 200 {
+		query.Limit = 50
+	}
+
+	sqlQuery := `SELECT id, chat, action, participant, actor, value, timestamp
+		FROM whatsapp_group_history WHERE jid=$1 AND chat=$2`
+	sqlArgs := []interface{}{jid, groupJID.String()}
+
+	if len(query.Action) > 0 {
+		sqlArgs = append(sqlArgs, query.Action)
+		sqlQuery += " AND action=$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	if query.Before > 0 {
+		sqlArgs = append(sqlArgs, query.Before)
+		sqlQuery += " AND id<$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	// Query One More Row to Know if Next Page is Exist
+	sqlArgs = append(sqlArgs, query.Limit+1)
+	sqlQuery += " ORDER BY id DESC LIMIT $" + strconv.Itoa(len(sqlArgs))
+
+	rows, err := datastore.DB.Query(sqlQuery, sqlArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &GroupHistoryPage{
+		Entries: []GroupHistoryEntry{},
+	}
+
+	for rows.Next() {
+		var entry GroupHistoryEntry
+
+		err = rows.Scan(&entry.Cursor, &entry.Chat, &entry.Action, &entry.Participant, &entry.Actor, &entry.Value, &entry.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Entries = append(page.Entries, entry)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(page.Entries) > query.Limit {
+		page.Entries = page.Entries[:query.Limit]
+		page.NextCursor = page.Entries[query.Limit-1].Cursor
+	}
+
+	return page, nil
+}
@@ -0,0 +1,252 @@
+package whatsapp
+
+import (
+	"strconv"
+	"time"
+
+	waproto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+)
+
+const (
+	HistoryDirectionIncoming = "incoming"
+	HistoryDirectionOutgoing = "outgoing"
+
+	HistoryStatusFailed   = "failed"
+	HistoryStatusSent     = "sent"
+	HistoryStatusReceived = "received"
+)
+
+type HistoryMessage struct {
+	Cursor int64 `json:"cursor"`
+	EventMessage
+	Direction string    `json:"direction"`
+	Status    string    `json:"status"`
+	IsRevoked bool      `json:"is_revoked"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type HistoryQuery struct {
+	Chat   string
+	Type   string
+	Before int64
+	Limit  int
+}
+
+type HistoryPage struct {
+	Messages   []HistoryMessage `json:"messages"`
+	NextCursor int64            `json:"next_cursor,omitempty"`
+}
+
+var whatsAppHistoryMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE whatsapp_message (
+				id         INTEGER PRIMARY KEY AUTOINCREMENT,
+				jid        TEXT NOT NULL,
+				chat       TEXT NOT NULL,
+				msgid      TEXT NOT NULL,
+				sender     TEXT NOT NULL,
+				pushname   TEXT NOT NULL,
+				direction  TEXT NOT NULL,
+				type       TEXT NOT NULL,
+				text       TEXT NOT NULL,
+				mimetype   TEXT NOT NULL,
+				filename   TEXT NOT NULL,
+				filesize   INTEGER NOT NULL,
+				reference  TEXT NOT NULL,
+				status     TEXT NOT NULL,
+				is_group   BOOLEAN NOT NULL,
+				is_edit    BOOLEAN NOT NULL,
+				is_revoked BOOLEAN NOT NULL,
+				timestamp  TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL,
+				UNIQUE (jid, chat, msgid)
+			)`,
+			`CREATE INDEX whatsapp_message_chat_idx ON whatsapp_message (jid, chat, id)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE whatsapp_message (
+				id         BIGSERIAL PRIMARY KEY,
+				jid        TEXT NOT NULL,
+				chat       TEXT NOT NULL,
+				msgid      TEXT NOT NULL,
+				sender     TEXT NOT NULL,
+				pushname   TEXT NOT NULL,
+				direction  TEXT NOT NULL,
+				type       TEXT NOT NULL,
+				text       TEXT NOT NULL,
+				mimetype   TEXT NOT NULL,
+				filename   TEXT NOT NULL,
+				filesize   BIGINT NOT NULL,
+				reference  TEXT NOT NULL,
+				status     TEXT NOT NULL,
+				is_group   BOOLEAN NOT NULL,
+				is_edit    BOOLEAN NOT NULL,
+				is_revoked BOOLEAN NOT NULL,
+				timestamp  TIMESTAMPTZ NOT NULL,
+				updated_at TIMESTAMPTZ NOT NULL,
+				UNIQUE (jid, chat, msgid)
+			)`,
+			`CREATE INDEX whatsapp_message_chat_idx ON whatsapp_message (jid, chat, id)`,
+		},
+	},
+}
+
+func init() {
+	datastore.Register("whatsapp_message", whatsAppHistoryMigrations)
+}
+
+// WhatsAppHistorySave Save Message to Message History
+// Edit and Revoke Message Will Update The Referenced Message Instead
+func WhatsAppHistorySave(jid string, msg *EventMessage, direction string, status string) error {
+	var err error
+	now := time.Now().UTC()
+
+	switch msg.Type {
+	case "edit":
+		_, err = datastore.DB.Exec("UPDATE whatsapp_message SET text=$1, is_edit=$2, updated_at=$3 WHERE jid=$4 AND chat=$5 AND msgid=$6",
+			msg.Text, true, now, jid, msg.Chat, msg.Reference)
+
+	case "revoke":
+		_, err = datastore.DB.Exec("UPDATE whatsapp_message SET is_revoked=$1, updated_at=$2 WHERE jid=$3 AND chat=$4 AND msgid=$5",
+			true, now, jid, msg.Chat, msg.Reference)
+
+	case "unknown":
+		// Nothing to Save for Unsupported Message
+
+	default:
+		timestamp := msg.Timestamp
+		if timestamp.IsZero() {
+			timestamp = now
+		}
+
+		// Message From History Sync Can Be Received More Than Once
+		_, err = datastore.DB.Exec(`INSERT INTO whatsapp_message
+			(jid, chat, msgid, sender, pushname, direction, type, text, mimetype, filename, filesize, reference, status, is_group, is_edit, is_revoked, timestamp, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+			ON CONFLICT (jid, chat, msgid) DO NOTHING`,
+			jid, msg.Chat, msg.ID, msg.Sender, msg.PushName, direction, msg.Type, msg.Text, msg.MimeType, msg.FileName, int64(msg.FileSize),
+			msg.Reference, status, msg.IsGroup, msg.IsEdit, false, timestamp.UTC(), now)
+	}
+
+	return err
+}
+
+// WhatsAppHistorySaveSent Save Outgoing Message With Sent or Failed Status
+func WhatsAppHistorySaveSent(jid string, rjid types.JID, msgID string, message *waproto.Message, sendErr error) {
+	msg := &EventMessage{
+		ID:        msgID,
+		Chat:      rjid.String(),
+		IsFromMe:  true,
+		IsGroup:   rjid.Server == types.GroupServer,
+		Timestamp: time.Now().UTC(),
+	}
+
+	if client := WhatsAppSession.Get(jid); client != nil && client.Store.ID != nil {
+		msg.Sender = client.Store.ID.ToNonAD().String()
+	}
+
+	WhatsAppMessageContent(message, msg)
+
+	status := HistoryStatusSent
+	if sendErr != nil {
+		// Failed Edit or Revoke Should Not Change The Referenced Message
+		if msg.Type == "edit" || msg.Type == "revoke" {
+			return
+		}
+
+		status = HistoryStatusFailed
+	}
+
+	err := WhatsAppHistorySave(jid, msg, HistoryDirectionOutgoing, status)
+	if err != nil {
+		log.Print(nil).Error("Failed to Save WhatsApp Message History: " + err.Error())
+	}
+}
+
+func whatsAppHistoryHandleMessage(jid string, evt *events.Message, msg *EventMessage) {
+	direction, status := HistoryDirectionIncoming, HistoryStatusReceived
+	if evt.Info.IsFromMe {
+		direction, status = HistoryDirectionOutgoing, HistoryStatusSent
+	}
+
+	err := WhatsAppHistorySave(jid, msg, direction, status)
+	if err != nil {
+		log.Print(nil).Error("Failed to Save WhatsApp Message History: " + err.Error())
+	}
+}
+
+// WhatsAppHistoryQuery Get Message History Ordered From The Newest Message
+// Next Page Can Be Requested Using Next Cursor as Before Cursor
+func WhatsAppHistoryQuery(jid string, query HistoryQuery) (*HistoryPage, error) {
+	if query.Limit <= 0 || query.Limit > 200 {
+		query.Limit = 50
+	}
+
+	sqlQuery := `SELECT id, chat, msgid, sender, pushname, direction, type, text, mimetype, filename, filesize, reference, status, is_group, is_edit, is_revoked, timestamp, updated_at
+		FROM whatsapp_message WHERE jid=$1`
+	sqlArgs := []interface{}{jid}
+
+	if len(query.Chat) > 0 {
+		sqlArgs = append(sqlArgs, query.Chat)
+		sqlQuery += " AND chat=$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	if len(query.Type) > 0 {
+		sqlArgs = append(sqlArgs, query.Type)
+		sqlQuery += " AND type=$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	if query.Before > 0 {
+		sqlArgs = append(sqlArgs, query.Before)
+		sqlQuery += " AND id<$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	// Query One More Row to Know if Next Page is Exist
+	sqlArgs = append(sqlArgs, query.Limit+1)
+	sqlQuery += " ORDER BY id DESC LIMIT $" + strconv.Itoa(len(sqlArgs))
+
+	rows, err := datastore.DB.Query(sqlQuery, sqlArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &HistoryPage{
+		Messages: []HistoryMessage{},
+	}
+
+	for rows.Next() {
+		var msg HistoryMessage
+		var fileSize int64
+
+		err = rows.Scan(&msg.Cursor, &msg.Chat, &msg.ID, &msg.Sender, &msg.PushName, &msg.Direction, &msg.Type, &msg.Text,
+			&msg.MimeType, &msg.FileName, &fileSize, &msg.Reference, &msg.Status, &msg.IsGroup, &msg.IsEdit, &msg.IsRevoked,
+			&msg.Timestamp, &msg.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.FileSize = uint64(fileSize)
+		msg.IsFromMe = msg.Direction == HistoryDirectionOutgoing
+
+		page.Messages = append(page.Messages, msg)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(page.Messages) > query.Limit {
+		page.Messages = page.Messages[:query.Limit]
+		page.NextCursor = page.Messages[query.Limit-1].Cursor
+	}
+
+	return page, nil
+}
@@ -0,0 +1,356 @@
+package whatsapp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/sunshineplan/imgconv"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+)
+
+type LinkPreview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	Thumbnail   []byte
+}
+
+var (
+	WhatsAppLinkPreviewTimeout      time.Duration
+	WhatsAppLinkPreviewMaxSize      int64
+	WhatsAppLinkPreviewAllowedHosts []string
+)
+
+func init() {
+	linkPreviewTimeout, err := env.GetEnvInt("WHATSAPP_LINK_PREVIEW_TIMEOUT")
+	if err != nil || linkPreviewTimeout <= 0 {
+		linkPreviewTimeout = 10
+	}
+
+	linkPreviewMaxSize, err := env.GetEnvInt("WHATSAPP_LINK_PREVIEW_MAX_SIZE")
+	if err != nil || linkPreviewMaxSize <= 0 {
+		linkPreviewMaxSize = 2 * 1024 * 1024
+	}
+
+	linkPreviewAllowedHosts, _ := env.GetEnvString("WHATSAPP_LINK_PREVIEW_ALLOWED_HOSTS")
+	for _, host := range strings.Split(linkPreviewAllowedHosts, ",") {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if len(host) > 0 {
+			WhatsAppLinkPreviewAllowedHosts = append(WhatsAppLinkPreviewAllowedHosts, host)
+		}
+	}
+
+	WhatsAppLinkPreviewTimeout = time.Duration(linkPreviewTimeout) * time.Second
+	WhatsAppLinkPreviewMaxSize = int64(linkPreviewMaxSize)
+}
+
+// WhatsAppLinkPreviewIsHostAllowed Check Host Against Link Preview Allow-List
+// Entry Started With "*." Will Match Every Sub-Domain
+func WhatsAppLinkPreviewIsHostAllowed(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	// Every Host is Allowed When Allow-List is Empty
+	// But Private Network Address Will Be Blocked While Dialing
+	if len(WhatsAppLinkPreviewAllowedHosts) == 0 {
+		return true
+	}
+
+	for _, allowedHost := range WhatsAppLinkPreviewAllowedHosts {
+		if allowedHost == host {
+			return true
+		}
+
+		if strings.HasPrefix(allowedHost, "*.") && strings.HasSuffix(host, allowedHost[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WhatsAppDialControl Make Sure Resolved Address is Public Address Before Connecting
+// Used as Dialer Control So Host Resolving to Private Network Address Can Not Bypass Host Check
+func WhatsAppDialControl(network string, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if !whatsAppDialIsPublic(net.ParseIP(host)) {
+		return errors.New("Dial Address is Not Allowed")
+	}
+
+	return nil
+}
+
+// Special-Purpose Address Blocks From IANA Registry That Should Never Be Dialed
+// Includes Shared Address Space (CGNAT) Where Some Cloud Metadata Endpoints Live
+var whatsAppDialDeniedNetworks = whatsAppParseCIDRs(
+	// IPv4
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.0.2.0/24",
+	"192.88.99.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"198.51.100.0/24",
+	"203.0.113.0/24",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+
+	// IPv6
+	"::/96",
+	"64:ff9b:1::/48",
+	"100::/64",
+	"2001::/23",
+	"2001:db8::/32",
+	"2002::/16",
+	"fc00::/7",
+	"fe80::/10",
+	"fec0::/10",
+	"ff00::/8",
+)
+
+// NAT64 Address Embed IPv4 Address in The Last 4 Bytes
+var whatsAppDialNAT64Network = whatsAppParseCIDRs("64:ff9b::/96")[0]
+
+var whatsAppDialIsPublic = func(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	// IPv4-Mapped and NAT64 Address is Checked as Its IPv4 Address
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	} else if whatsAppDialNAT64Network.Contains(ip) {
+		ip = net.IPv4(ip[12], ip[13], ip[14], ip[15]).To4()
+	}
+
+	for _, network := range whatsAppDialDeniedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func whatsAppParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+
+		networks[i] = network
+	}
+
+	return networks
+}
+
+func whatsAppLinkPreviewClient() *http.Client {
+	// Private Network Address is Always Rejected
+	// Even When Host is Listed in Allow-List
+	dialer := &net.Dialer{
+		Timeout: WhatsAppLinkPreviewTimeout,
+		Control: WhatsAppDialControl,
+	}
+
+	return &http.Client{
+		Timeout: WhatsAppLinkPreviewTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: WhatsAppLinkPreviewTimeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("Link Preview Has Too Many Redirects")
+			}
+
+			if !WhatsAppLinkPreviewIsHostAllowed(req.URL.Hostname()) {
+				return errors.New("Link Preview Host is Not Allowed")
+			}
+
+			return nil
+		},
+	}
+}
+
+func whatsAppLinkPreviewGet(ctx context.Context, client *http.Client, link string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; WhatsApp/2)")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, "", errors.New("Link Preview Returned Status " + res.Status)
+	}
+
+	if res.ContentLength > WhatsAppLinkPreviewMaxSize {
+		return nil, "", errors.New("Link Preview Content is Too Large")
+	}
+
+	// Read Content Until Size Limit Plus One Byte
+	// To Detect Content That is Larger Than Size Limit
+	content, err := io.ReadAll(io.LimitReader(res.Body, WhatsAppLinkPreviewMaxSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if int64(len(content)) > WhatsAppLinkPreviewMaxSize {
+		return nil, "", errors.New("Link Preview Content is Too Large")
+	}
+
+	contentType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+
+	return content, contentType, nil
+}
+
+// WhatsAppLinkPreviewFetch Fetch Link and Read OpenGraph / Twitter Card Information
+func WhatsAppLinkPreviewFetch(ctx context.Context, link string) (*LinkPreview, error) {
+	linkURL, err := url.Parse(link)
+	if err != nil {
+		return nil, errors.New("Link URL is Not Valid")
+	}
+
+	if linkURL.Scheme != "http" && linkURL.Scheme != "https" {
+		return nil, errors.New("Link URL Scheme Should be HTTP or HTTPS")
+	}
+
+	if !WhatsAppLinkPreviewIsHostAllowed(linkURL.Hostname()) {
+		return nil, errors.New("Link Preview Host is Not Allowed")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, WhatsAppLinkPreviewTimeout)
+	defer cancel()
+
+	client := whatsAppLinkPreviewClient()
+
+	content, contentType, err := whatsAppLinkPreviewGet(ctx, client, linkURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "text/html" && contentType != "application/xhtml+xml" {
+		return nil, errors.New("Link Preview Content is Not HTML Document")
+	}
+
+	document, err := goquery.NewDocumentFromReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find First Non Empty Meta Content From Given Property or Name
+	metaContent := func(keys ...string) string {
+		for _, key := range keys {
+			var value string
+
+			document.Find("meta").EachWithBreak(func(_ int, meta *goquery.Selection) bool {
+				property, _ := meta.Attr("property")
+				name, _ := meta.Attr("name")
+
+				if strings.EqualFold(property, key) || strings.EqualFold(name, key) {
+					value = strings.TrimSpace(meta.AttrOr("content", ""))
+				}
+
+				return len(value) == 0
+			})
+
+			if len(value) > 0 {
+				return value
+			}
+		}
+
+		return ""
+	}
+
+	preview := &LinkPreview{
+		URL:         linkURL.String(),
+		Title:       metaContent("og:title", "twitter:title"),
+		Description: metaContent("og:description", "twitter:description", "description"),
+		ImageURL:    metaContent("og:image", "og:image:url", "twitter:image", "twitter:image:src"),
+	}
+
+	if len(preview.Title) == 0 {
+		preview.Title = strings.TrimSpace(document.Find("title").First().Text())
+	}
+
+	// Canonical URL From Page is Only Used When It Points to The Same Host
+	// So Page Can Not Make Preview Point to Other Site
+	if canonicalURL := metaContent("og:url"); len(canonicalURL) > 0 {
+		if canonicalURLParsed, err := linkURL.Parse(canonicalURL); err == nil && strings.EqualFold(canonicalURLParsed.Hostname(), linkURL.Hostname()) {
+			preview.URL = canonicalURLParsed.String()
+		}
+	}
+
+	// Creating Link Preview JPEG Thumbnail
+	// Failing to Get Thumbnail Should Not Fail The Link Preview
+	if len(preview.ImageURL) > 0 {
+		imageURL, err := linkURL.Parse(preview.ImageURL)
+		if err == nil && (imageURL.Scheme == "http" || imageURL.Scheme == "https") && WhatsAppLinkPreviewIsHostAllowed(imageURL.Hostname()) {
+			preview.ImageURL = imageURL.String()
+
+			imageContent, _, err := whatsAppLinkPreviewGet(ctx, client, preview.ImageURL)
+			if err == nil {
+				preview.Thumbnail, _ = whatsAppLinkPreviewThumbnail(imageContent)
+			}
+		}
+	}
+
+	return preview, nil
+}
+
+func whatsAppLinkPreviewThumbnail(imageContent []byte) ([]byte, error) {
+	// Make Sure Image Dimension is Reasonable Before Decoding
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(imageContent))
+	if err != nil {
+		return nil, err
+	}
+
+	if imageConfig.Width*imageConfig.Height > 40000000 {
+		return nil, errors.New("Link Preview Image Dimension is Too Large")
+	}
+
+	imgThumbDecode, err := imgconv.Decode(bytes.NewReader(imageContent))
+	if err != nil {
+		return nil, err
+	}
+
+	imgThumbEncode := new(bytes.Buffer)
+
+	err = imgconv.Write(imgThumbEncode,
+		imgconv.Resize(imgThumbDecode, &imgconv.ResizeOption{Width: 160}),
+		&imgconv.FormatOption{Format: imgconv.JPEG})
+	if err != nil {
+		return nil, err
+	}
+
+	return imgThumbEncode.Bytes(), nil
+}
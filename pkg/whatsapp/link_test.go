@@ -0,0 +1,276 @@
+package whatsapp
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// linkPreviewTestSetup Allow Dialing Local Test Server and Restore Link Preview Settings After Test
+func linkPreviewTestSetup(t *testing.T, allowPrivate bool, allowedHosts ...string) {
+	dialIsPublic := whatsAppDialIsPublic
+	maxSize := WhatsAppLinkPreviewMaxSize
+	hosts := WhatsAppLinkPreviewAllowedHosts
+
+	t.Cleanup(func() {
+		whatsAppDialIsPublic = dialIsPublic
+		WhatsAppLinkPreviewMaxSize = maxSize
+		WhatsAppLinkPreviewAllowedHosts = hosts
+	})
+
+	if allowPrivate {
+		whatsAppDialIsPublic = func(ip net.IP) bool {
+			return true
+		}
+	}
+
+	WhatsAppLinkPreviewAllowedHosts = allowedHosts
+}
+
+func linkPreviewTestServer(t *testing.T, pages map[string]string) *httptest.Server {
+	imageContent := new(bytes.Buffer)
+	if err := png.Encode(imageContent, image.NewRGBA(image.Rect(0, 0, 320, 240))); err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/image.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(imageContent.Bytes())
+	})
+
+	for path, page := range pages {
+		page := page
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(page))
+		})
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestWhatsAppLinkPreviewFetchOpenGraph(t *testing.T) {
+	linkPreviewTestSetup(t, true)
+
+	server := linkPreviewTestServer(t, map[string]string{
+		"/": `<html><head>
+			<title>Fallback Title</title>
+			<meta property="og:title" content="OpenGraph Title">
+			<meta property="og:description" content="OpenGraph Description">
+			<meta property="og:image" content="/image.png">
+			<meta property="og:url" content="/canonical">
+			<meta name="twitter:title" content="Twitter Title">
+		</head></html>`,
+	})
+
+	preview, err := WhatsAppLinkPreviewFetch(context.Background(), server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if preview.Title != "OpenGraph Title" {
+		t.Errorf("Title = %q, want %q", preview.Title, "OpenGraph Title")
+	}
+
+	if preview.Description != "OpenGraph Description" {
+		t.Errorf("Description = %q, want %q", preview.Description, "OpenGraph Description")
+	}
+
+	if preview.URL != server.URL+"/canonical" {
+		t.Errorf("URL = %q, want %q", preview.URL, server.URL+"/canonical")
+	}
+
+	if preview.ImageURL != server.URL+"/image.png" {
+		t.Errorf("ImageURL = %q, want %q", preview.ImageURL, server.URL+"/image.png")
+	}
+
+	if len(preview.Thumbnail) == 0 {
+		t.Error("Thumbnail is empty")
+	}
+}
+
+func TestWhatsAppLinkPreviewFetchTwitterCard(t *testing.T) {
+	linkPreviewTestSetup(t, true)
+
+	server := linkPreviewTestServer(t, map[string]string{
+		"/": `<html><head>
+			<meta name="twitter:title" content="Twitter Title">
+			<meta name="twitter:description" content="Twitter Description">
+			<meta name="twitter:image" content="/image.png">
+			<meta property="og:url" content="https://other.example.com/">
+		</head></html>`,
+	})
+
+	preview, err := WhatsAppLinkPreviewFetch(context.Background(), server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if preview.Title != "Twitter Title" {
+		t.Errorf("Title = %q, want %q", preview.Title, "Twitter Title")
+	}
+
+	if preview.Description != "Twitter Description" {
+		t.Errorf("Description = %q, want %q", preview.Description, "Twitter Description")
+	}
+
+	// Canonical URL Pointing to Other Host Should Be Ignored
+	if preview.URL != server.URL+"/" {
+		t.Errorf("URL = %q, want %q", preview.URL, server.URL+"/")
+	}
+
+	if len(preview.Thumbnail) == 0 {
+		t.Error("Thumbnail is empty")
+	}
+}
+
+func TestWhatsAppLinkPreviewFetchTitleFallback(t *testing.T) {
+	linkPreviewTestSetup(t, true)
+
+	server := linkPreviewTestServer(t, map[string]string{
+		"/": `<html><head><title> Page Title </title></head></html>`,
+	})
+
+	preview, err := WhatsAppLinkPreviewFetch(context.Background(), server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if preview.Title != "Page Title" {
+		t.Errorf("Title = %q, want %q", preview.Title, "Page Title")
+	}
+}
+
+func TestWhatsAppLinkPreviewFetchSizeLimit(t *testing.T) {
+	linkPreviewTestSetup(t, true)
+
+	server := linkPreviewTestServer(t, map[string]string{
+		"/": `<html><head><title>` + strings.Repeat("A", 1024) + `</title></head></html>`,
+	})
+
+	WhatsAppLinkPreviewMaxSize = 512
+
+	_, err := WhatsAppLinkPreviewFetch(context.Background(), server.URL+"/")
+	if err == nil || !strings.Contains(err.Error(), "Too Large") {
+		t.Fatalf("err = %v, want content too large error", err)
+	}
+}
+
+func TestWhatsAppLinkPreviewFetchAllowList(t *testing.T) {
+	linkPreviewTestSetup(t, true, "example.com")
+
+	server := linkPreviewTestServer(t, map[string]string{
+		"/": `<html><head><title>Title</title></head></html>`,
+	})
+
+	_, err := WhatsAppLinkPreviewFetch(context.Background(), server.URL+"/")
+	if err == nil || !strings.Contains(err.Error(), "Host is Not Allowed") {
+		t.Fatalf("err = %v, want host not allowed error", err)
+	}
+}
+
+func TestWhatsAppLinkPreviewFetchRedirect(t *testing.T) {
+	linkPreviewTestSetup(t, true, "127.0.0.1")
+
+	server := linkPreviewTestServer(t, map[string]string{})
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Redirect to The Same Server Using Host That is Not in Allow-List
+		http.Redirect(w, r, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)+"/", http.StatusFound)
+	}))
+	t.Cleanup(redirect.Close)
+
+	_, err := WhatsAppLinkPreviewFetch(context.Background(), redirect.URL+"/")
+	if err == nil || !strings.Contains(err.Error(), "Host is Not Allowed") {
+		t.Fatalf("err = %v, want host not allowed error", err)
+	}
+}
+
+func TestWhatsAppLinkPreviewFetchPrivateAddress(t *testing.T) {
+	// Private Network Address Should Be Rejected Even When Host is in Allow-List
+	linkPreviewTestSetup(t, false, "127.0.0.1")
+
+	server := linkPreviewTestServer(t, map[string]string{
+		"/": `<html><head><title>Title</title></head></html>`,
+	})
+
+	_, err := WhatsAppLinkPreviewFetch(context.Background(), server.URL+"/")
+	if err == nil || !strings.Contains(err.Error(), "Dial Address is Not Allowed") {
+		t.Fatalf("err = %v, want dial address not allowed error", err)
+	}
+}
+
+func TestWhatsAppLinkPreviewIsHostAllowed(t *testing.T) {
+	linkPreviewTestSetup(t, false, "example.com", "*.example.org")
+
+	tests := map[string]bool{
+		"example.com":      true,
+		"EXAMPLE.COM.":     true,
+		"www.example.com":  false,
+		"www.example.org":  true,
+		"example.org":      false,
+		"badexample.org":   false,
+		"other.example.io": false,
+	}
+
+	for host, want := range tests {
+		if got := WhatsAppLinkPreviewIsHostAllowed(host); got != want {
+			t.Errorf("WhatsAppLinkPreviewIsHostAllowed(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+func TestWhatsAppDialIsPublic(t *testing.T) {
+	tests := map[string]bool{
+		"8.8.8.8":                true,
+		"1.1.1.1":                true,
+		"2606:4700:4700::1111":   true,
+		"64:ff9b::808:808":       true,
+		"::ffff:8.8.8.8":         true,
+		"127.0.0.1":              false,
+		"10.1.2.3":               false,
+		"172.16.0.1":             false,
+		"192.168.1.1":            false,
+		"169.254.169.254":        false,
+		"100.64.0.1":             false,
+		"100.100.100.200":        false,
+		"198.18.0.1":             false,
+		"198.19.255.255":         false,
+		"192.0.0.8":              false,
+		"192.0.2.1":              false,
+		"203.0.113.1":            false,
+		"224.0.0.1":              false,
+		"255.255.255.255":        false,
+		"0.0.0.0":                false,
+		"::":                     false,
+		"::1":                    false,
+		"::127.0.0.1":            false,
+		"::ffff:127.0.0.1":       false,
+		"::ffff:169.254.169.254": false,
+		"64:ff9b::a00:1":         false,
+		"64:ff9b::7f00:1":        false,
+		"64:ff9b:1::1":           false,
+		"2001::1":                false,
+		"2001:db8::1":            false,
+		"2002:a00:1::1":          false,
+		"fe80::1":                false,
+		"fec0::1":                false,
+		"fd00::1":                false,
+		"ff02::1":                false,
+	}
+
+	for address, want := range tests {
+		if got := whatsAppDialIsPublic(net.ParseIP(address)); got != want {
+			t.Errorf("whatsAppDialIsPublic(%q) = %v, want %v", address, got, want)
+		}
+	}
+}
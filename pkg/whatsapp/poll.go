@@ -0,0 +1,306 @@
+package whatsapp
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	waproto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+)
+
+type PollOptionResult struct {
+	Name   string   `json:"name"`
+	Votes  int      `json:"votes"`
+	Voters []string `json:"voters"`
+}
+
+type PollResult struct {
+	MsgID       string             `json:"msgid"`
+	Chat        string             `json:"chat"`
+	Question    string             `json:"question"`
+	MultiAnswer bool               `json:"multianswer"`
+	Options     []PollOptionResult `json:"options"`
+	TotalVoters int                `json:"total_voters"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+var ErrWhatsAppPollNotFound = errors.New("WhatsApp Poll is Not Found")
+
+var whatsAppPollMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE whatsapp_poll (
+				jid          TEXT NOT NULL,
+				msgid        TEXT NOT NULL,
+				chat         TEXT NOT NULL,
+				question     TEXT NOT NULL,
+				multi_answer BOOLEAN NOT NULL,
+				options      TEXT NOT NULL,
+				created_at   TIMESTAMP NOT NULL,
+				updated_at   TIMESTAMP NOT NULL,
+				PRIMARY KEY (jid, msgid)
+			)`,
+			`CREATE TABLE whatsapp_poll_vote (
+				jid        TEXT NOT NULL,
+				msgid      TEXT NOT NULL,
+				voter      TEXT NOT NULL,
+				options    TEXT NOT NULL,
+				updated_at TIMESTAMP NOT NULL,
+				PRIMARY KEY (jid, msgid, voter)
+			)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE whatsapp_poll (
+				jid          TEXT NOT NULL,
+				msgid        TEXT NOT NULL,
+				chat         TEXT NOT NULL,
+				question     TEXT NOT NULL,
+				multi_answer BOOLEAN NOT NULL,
+				options      TEXT NOT NULL,
+				created_at   TIMESTAMPTZ NOT NULL,
+				updated_at   TIMESTAMPTZ NOT NULL,
+				PRIMARY KEY (jid, msgid)
+			)`,
+			`CREATE TABLE whatsapp_poll_vote (
+				jid        TEXT NOT NULL,
+				msgid      TEXT NOT NULL,
+				voter      TEXT NOT NULL,
+				options    TEXT NOT NULL,
+				updated_at TIMESTAMPTZ NOT NULL,
+				PRIMARY KEY (jid, msgid, voter)
+			)`,
+		},
+	},
+}
+
+func init() {
+	datastore.Register("whatsapp_poll", whatsAppPollMigrations)
+}
+
+// WhatsAppPollSave Save Poll Question and Options So Incoming Votes Can Be Tallied
+// Existing Poll and Its Votes Are Kept if Poll is Already Saved
+func WhatsAppPollSave(jid string, chat types.JID, msgID string, poll *waproto.PollCreationMessage, createdAt time.Time) error {
+	if poll == nil {
+		return nil
+	}
+
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	options := []string{}
+	for _, option := range poll.GetOptions() {
+		options = append(options, option.GetOptionName())
+	}
+
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	_, err = datastore.DB.Exec(`INSERT INTO whatsapp_poll (jid, msgid, chat, question, multi_answer, options, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (jid, msgid) DO NOTHING`,
+		jid, msgID, chat.String(), poll.GetName(), poll.GetSelectableOptionsCount() != 1, string(optionsJSON), createdAt.UTC(), createdAt.UTC())
+
+	return err
+}
+
+// WhatsAppPollRegister Save Poll and Log Error When Failed
+func WhatsAppPollRegister(jid string, chat types.JID, msgID string, poll *waproto.PollCreationMessage, createdAt time.Time) {
+	err := WhatsAppPollSave(jid, chat, msgID, poll, createdAt)
+	if err != nil {
+		log.Print(nil).Error("Failed to Save WhatsApp Poll: " + err.Error())
+	}
+}
+
+func whatsAppPollGet(jid string, msgID string) (*PollResult, []string, error) {
+	var optionsJSON string
+
+	result := &PollResult{
+		MsgID: msgID,
+	}
+
+	err := datastore.DB.QueryRow(`SELECT chat, question, multi_answer, options, created_at, updated_at
+		FROM whatsapp_poll WHERE jid=$1 AND msgid=$2`, jid, msgID).
+		Scan(&result.Chat, &result.Question, &result.MultiAnswer, &optionsJSON, &result.CreatedAt, &result.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrWhatsAppPollNotFound
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	var options []string
+
+	err = json.Unmarshal([]byte(optionsJSON), &options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, options, nil
+}
+
+// WhatsAppPollResult Get Poll Vote Tally By Poll Message ID
+func WhatsAppPollResult(jid string, msgID string) (*PollResult, error) {
+	result, options, err := whatsAppPollGet(jid, msgID)
+	if err != nil {
+		return nil, err
+	}
+
+	optionIndex := make(map[string]int)
+	for i, option := range options {
+		optionIndex[option] = i
+		result.Options = append(result.Options, PollOptionResult{
+			Name:   option,
+			Voters: []string{},
+		})
+	}
+
+	rows, err := datastore.DB.Query("SELECT voter, options FROM whatsapp_poll_vote WHERE jid=$1 AND msgid=$2 ORDER BY voter", jid, msgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var voter, selectedJSON string
+
+		err = rows.Scan(&voter, &selectedJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		var selected []string
+
+		err = json.Unmarshal([]byte(selectedJSON), &selected)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(selected) == 0 {
+			continue
+		}
+
+		result.TotalVoters++
+		for _, option := range selected {
+			i, ok := optionIndex[option]
+			if !ok {
+				continue
+			}
+
+			result.Options[i].Votes++
+			result.Options[i].Voters = append(result.Options[i].Voters, voter)
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result.Options {
+		sort.Strings(result.Options[i].Voters)
+	}
+
+	return result, nil
+}
+
+// WhatsAppPollVoteSave Save Voter Selected Options
+// Every New Vote From The Same Voter Replace The Previous One
+func WhatsAppPollVoteSave(jid string, msgID string, voter string, selectedHashes [][]byte, timestamp time.Time) error {
+	_, options, err := whatsAppPollGet(jid, msgID)
+	if err != nil {
+		return err
+	}
+
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	optionHash := make(map[[sha256.Size]byte]string)
+	for _, option := range options {
+		optionHash[sha256.Sum256([]byte(option))] = option
+	}
+
+	selected := []string{}
+	for _, hash := range selectedHashes {
+		if len(hash) != sha256.Size {
+			continue
+		}
+
+		if option, ok := optionHash[[sha256.Size]byte(hash)]; ok {
+			selected = append(selected, option)
+		}
+	}
+
+	selectedJSON, err := json.Marshal(selected)
+	if err != nil {
+		return err
+	}
+
+	tx, err := datastore.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`INSERT INTO whatsapp_poll_vote (jid, msgid, voter, options, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (jid, msgid, voter) DO UPDATE SET options=excluded.options, updated_at=excluded.updated_at`,
+		jid, msgID, voter, string(selectedJSON), timestamp.UTC())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE whatsapp_poll SET updated_at=$1 WHERE jid=$2 AND msgid=$3", timestamp.UTC(), jid, msgID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func whatsAppPollHandleMessage(jid string, evt *events.Message) {
+	// Register Poll Creation Message Sent From Other Device or Received From Other User
+	for _, poll := range []*waproto.PollCreationMessage{
+		evt.Message.GetPollCreationMessage(),
+		evt.Message.GetPollCreationMessageV2(),
+		evt.Message.GetPollCreationMessageV3(),
+	} {
+		if poll != nil {
+			WhatsAppPollRegister(jid, evt.Info.Chat, evt.Info.ID, poll, evt.Info.Timestamp)
+			return
+		}
+	}
+
+	client := WhatsAppSession.Get(jid)
+
+	pollUpdate := evt.Message.GetPollUpdateMessage()
+	if pollUpdate == nil || client == nil {
+		return
+	}
+
+	// Decrypt Poll Vote Using Poll Creation Message Secret
+	pollVote, err := client.DecryptPollVote(evt)
+	if err != nil {
+		log.Print(nil).Error("Failed to Decrypt WhatsApp Poll Vote: " + err.Error())
+		return
+	}
+
+	msgID := pollUpdate.GetPollCreationMessageKey().GetID()
+
+	// Vote for Unknown Poll is Ignored
+	err = WhatsAppPollVoteSave(jid, msgID, evt.Info.Sender.ToNonAD().String(), pollVote.GetSelectedOptions(), evt.Info.Timestamp)
+	if err != nil && !errors.Is(err, ErrWhatsAppPollNotFound) {
+		log.Print(nil).Error("Failed to Save WhatsApp Poll Vote: " + err.Error())
+	}
+}
@@ -0,0 +1,107 @@
+package whatsapp
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	waproto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func pollTestHash(option string) []byte {
+	hash := sha256.Sum256([]byte(option))
+	return hash[:]
+}
+
+func TestWhatsAppPollVoteTally(t *testing.T) {
+	jid := "poll-test"
+	chat := types.NewJID("6281234567890", types.DefaultUserServer)
+
+	poll := &waproto.PollCreationMessage{
+		Name: proto.String("Lunch?"),
+		Options: []*waproto.PollCreationMessage_Option{
+			{OptionName: proto.String("Yes")},
+			{OptionName: proto.String("No")},
+		},
+		SelectableOptionsCount: proto.Uint32(1),
+	}
+
+	err := WhatsAppPollSave(jid, chat, "POLL1", poll, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	votes := []struct {
+		voter   string
+		options []string
+	}{
+		{"6281111111111@s.whatsapp.net", []string{"Yes"}},
+		{"6282222222222@s.whatsapp.net", []string{"Yes"}},
+		{"6282222222222@s.whatsapp.net", []string{"No"}},
+		{"6283333333333@s.whatsapp.net", []string{"Unknown"}},
+	}
+
+	for _, vote := range votes {
+		var hashes [][]byte
+		for _, option := range vote.options {
+			hashes = append(hashes, pollTestHash(option))
+		}
+
+		err = WhatsAppPollVoteSave(jid, "POLL1", vote.voter, hashes, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := WhatsAppPollResult(jid, "POLL1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Question != "Lunch?" || result.MultiAnswer || result.Chat != chat.String() {
+		t.Errorf("unexpected poll information %+v", result)
+	}
+
+	// Latest Vote Replace Previous One and Vote Without Known Option is Not Counted
+	if result.TotalVoters != 2 {
+		t.Errorf("TotalVoters = %d, want 2", result.TotalVoters)
+	}
+
+	if result.Options[0].Votes != 1 || result.Options[0].Voters[0] != "6281111111111@s.whatsapp.net" {
+		t.Errorf("unexpected option Yes result %+v", result.Options[0])
+	}
+
+	if result.Options[1].Votes != 1 || result.Options[1].Voters[0] != "6282222222222@s.whatsapp.net" {
+		t.Errorf("unexpected option No result %+v", result.Options[1])
+	}
+
+	// Saving The Same Poll Again Should Keep Its Votes
+	err = WhatsAppPollSave(jid, chat, "POLL1", poll, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = WhatsAppPollResult(jid, "POLL1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.TotalVoters != 2 {
+		t.Errorf("TotalVoters = %d after saving poll again, want 2", result.TotalVoters)
+	}
+}
+
+func TestWhatsAppPollNotFound(t *testing.T) {
+	_, err := WhatsAppPollResult("poll-test", "UNKNOWN")
+	if !errors.Is(err, ErrWhatsAppPollNotFound) {
+		t.Errorf("err = %v, want %v", err, ErrWhatsAppPollNotFound)
+	}
+
+	err = WhatsAppPollVoteSave("poll-test", "UNKNOWN", "6281111111111@s.whatsapp.net", nil, time.Now())
+	if !errors.Is(err, ErrWhatsAppPollNotFound) {
+		t.Errorf("err = %v, want %v", err, ErrWhatsAppPollNotFound)
+	}
+}
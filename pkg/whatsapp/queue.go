@@ -0,0 +1,734 @@
+package whatsapp
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+)
+
+const (
+	QueueTypeText     = "text"
+	QueueTypeLocation = "location"
+	QueueTypeLink     = "link"
+	QueueTypePoll     = "poll"
+	QueueTypeContact  = "contact"
+	QueueTypeDocument = "document"
+	QueueTypeImage    = "image"
+	QueueTypeAudio    = "audio"
+	QueueTypeVideo    = "video"
+	QueueTypeSticker  = "sticker"
+
+	QueueStateQueued  = "queued"
+	QueueStateSending = "sending"
+	QueueStateSent    = "sent"
+	QueueStateFailed  = "failed"
+)
+
+// QueueMessage Hold Every Parameter Needed to Send Message Later
+// Text is Used as Message, Caption, Poll Question or Document File Name
+type QueueMessage struct {
+	Type          string   `json:"type"`
+	RJID          string   `json:"rjid"`
+	Text          string   `json:"text,omitempty"`
+	URL           string   `json:"url,omitempty"`
+	Latitude      float64  `json:"latitude,omitempty"`
+	Longitude     float64  `json:"longitude,omitempty"`
+	Options       []string `json:"options,omitempty"`
+	IsMultiAnswer bool     `json:"is_multi_answer,omitempty"`
+	Contacts      []VCard  `json:"contacts,omitempty"`
+	FileBytes     []byte   `json:"file_bytes,omitempty"`
+	FileType      string   `json:"file_type,omitempty"`
+	IsViewOnce    bool     `json:"is_view_once,omitempty"`
+}
+
+type QueueJob struct {
+	ID        int64      `json:"id"`
+	Type      string     `json:"type"`
+	Chat      string     `json:"chat"`
+	State     string     `json:"state"`
+	MsgID     string     `json:"msgid,omitempty"`
+	Error     string     `json:"error,omitempty"`
+	Attempts  int        `json:"attempts"`
+	RunAt     time.Time  `json:"run_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	SentAt    *time.Time `json:"sent_at,omitempty"`
+}
+
+type QueueQuery struct {
+	State  string
+	Before int64
+	Limit  int
+}
+
+type QueuePage struct {
+	Jobs       []QueueJob `json:"jobs"`
+	NextCursor int64      `json:"next_cursor,omitempty"`
+}
+
+type queueBucket struct {
+	capacity float64
+	tokens   float64
+	refill   float64
+	last     time.Time
+}
+
+// queueLimiter Keep Rate Limit Buckets of JID
+// It is Kept After Worker Exit So Idle Worker Can Not Reset The Rate Limit
+type queueLimiter struct {
+	sync.Mutex
+	minute *queueBucket
+	hour   *queueBucket
+}
+
+type queueWorker struct {
+	wake    chan struct{}
+	stop    chan struct{}
+	limiter *queueLimiter
+}
+
+var (
+	WhatsAppQueueEnabled       bool
+	WhatsAppQueueRatePerMinute int
+	WhatsAppQueueRatePerHour   int
+	WhatsAppQueueJitterMin     time.Duration
+	WhatsAppQueueJitterMax     time.Duration
+	WhatsAppQueueMaxRetry      int
+	WhatsAppQueueSendTimeout   time.Duration
+	WhatsAppQueueRetention     time.Duration
+)
+
+var queueWorkers = struct {
+	sync.Mutex
+	data map[string]*queueWorker
+}{
+	data: make(map[string]*queueWorker),
+}
+
+var queueLimiters = struct {
+	sync.Mutex
+	data map[string]*queueLimiter
+}{
+	data: make(map[string]*queueLimiter),
+}
+
+var whatsAppQueueMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE whatsapp_queue (
+				id         INTEGER PRIMARY KEY AUTOINCREMENT,
+				jid        TEXT NOT NULL,
+				type       TEXT NOT NULL,
+				chat       TEXT NOT NULL,
+				payload    TEXT NOT NULL,
+				state      TEXT NOT NULL,
+				msgid      TEXT NOT NULL,
+				error      TEXT NOT NULL,
+				attempts   INTEGER NOT NULL,
+				run_at     TIMESTAMP NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL,
+				sent_at    TIMESTAMP
+			)`,
+			`CREATE INDEX whatsapp_queue_state_idx ON whatsapp_queue (jid, state, run_at)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE whatsapp_queue (
+				id         BIGSERIAL PRIMARY KEY,
+				jid        TEXT NOT NULL,
+				type       TEXT NOT NULL,
+				chat       TEXT NOT NULL,
+				payload    TEXT NOT NULL,
+				state      TEXT NOT NULL,
+				msgid      TEXT NOT NULL,
+				error      TEXT NOT NULL,
+				attempts   INTEGER NOT NULL,
+				run_at     TIMESTAMPTZ NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL,
+				updated_at TIMESTAMPTZ NOT NULL,
+				sent_at    TIMESTAMPTZ
+			)`,
+			`CREATE INDEX whatsapp_queue_state_idx ON whatsapp_queue (jid, state, run_at)`,
+		},
+	},
+}
+
+func init() {
+	var err error
+
+	WhatsAppQueueEnabled, _ = env.GetEnvBool("WHATSAPP_QUEUE_ENABLED")
+
+	WhatsAppQueueRatePerMinute, err = env.GetEnvInt("WHATSAPP_QUEUE_RATE_PER_MINUTE")
+	if err != nil || WhatsAppQueueRatePerMinute <= 0 {
+		WhatsAppQueueRatePerMinute = 20
+	}
+
+	WhatsAppQueueRatePerHour, err = env.GetEnvInt("WHATSAPP_QUEUE_RATE_PER_HOUR")
+	if err != nil || WhatsAppQueueRatePerHour <= 0 {
+		WhatsAppQueueRatePerHour = 300
+	}
+
+	queueJitterMin, err := env.GetEnvInt("WHATSAPP_QUEUE_JITTER_MIN")
+	if err != nil || queueJitterMin < 0 {
+		queueJitterMin = 1
+	}
+
+	queueJitterMax, err := env.GetEnvInt("WHATSAPP_QUEUE_JITTER_MAX")
+	if err != nil || queueJitterMax < queueJitterMin {
+		queueJitterMax = queueJitterMin + 2
+	}
+
+	WhatsAppQueueJitterMin = time.Duration(queueJitterMin) * time.Second
+	WhatsAppQueueJitterMax = time.Duration(queueJitterMax) * time.Second
+
+	WhatsAppQueueMaxRetry, err = env.GetEnvInt("WHATSAPP_QUEUE_MAX_RETRY")
+	if err != nil || WhatsAppQueueMaxRetry < 0 {
+		WhatsAppQueueMaxRetry = 3
+	}
+
+	queueSendTimeout, err := env.GetEnvInt("WHATSAPP_QUEUE_SEND_TIMEOUT")
+	if err != nil || queueSendTimeout <= 0 {
+		queueSendTimeout = 60
+	}
+
+	WhatsAppQueueSendTimeout = time.Duration(queueSendTimeout) * time.Second
+
+	queueRetention, err := env.GetEnvInt("WHATSAPP_QUEUE_RETENTION_HOUR")
+	if err != nil || queueRetention <= 0 {
+		queueRetention = 168
+	}
+
+	WhatsAppQueueRetention = time.Duration(queueRetention) * time.Hour
+
+	datastore.Register("whatsapp_queue", whatsAppQueueMigrations)
+}
+
+func newQueueBucket(rate int, period time.Duration) *queueBucket {
+	return &queueBucket{
+		capacity: float64(rate),
+		tokens:   float64(rate),
+		refill:   float64(rate) / period.Seconds(),
+		last:     time.Now(),
+	}
+}
+
+// Wait Get Duration Until One Token is Available
+func (bucket *queueBucket) Wait() time.Duration {
+	now := time.Now()
+
+	bucket.tokens += now.Sub(bucket.last).Seconds() * bucket.refill
+	if bucket.tokens > bucket.capacity {
+		bucket.tokens = bucket.capacity
+	}
+	bucket.last = now
+
+	if bucket.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - bucket.tokens) / bucket.refill * float64(time.Second))
+}
+
+// Take Consume One Token
+func (bucket *queueBucket) Take() {
+	bucket.Wait()
+	bucket.tokens--
+}
+
+func whatsAppQueueLimiter(jid string) *queueLimiter {
+	queueLimiters.Lock()
+	defer queueLimiters.Unlock()
+
+	limiter := queueLimiters.data[jid]
+	if limiter == nil {
+		limiter = &queueLimiter{
+			minute: newQueueBucket(WhatsAppQueueRatePerMinute, time.Minute),
+			hour:   newQueueBucket(WhatsAppQueueRatePerHour, time.Hour),
+		}
+
+		queueLimiters.data[jid] = limiter
+	}
+
+	return limiter
+}
+
+// Wait Get Duration Until Both Per Minute and Per Hour Token is Available
+func (limiter *queueLimiter) Wait() time.Duration {
+	limiter.Lock()
+	defer limiter.Unlock()
+
+	waitDuration := limiter.minute.Wait()
+	if hourWait := limiter.hour.Wait(); hourWait > waitDuration {
+		waitDuration = hourWait
+	}
+
+	return waitDuration
+}
+
+// Take Consume Both Per Minute and Per Hour Token
+func (limiter *queueLimiter) Take() {
+	limiter.Lock()
+	defer limiter.Unlock()
+
+	limiter.minute.Take()
+	limiter.hour.Take()
+}
+
+// WhatsAppQueueSend Send Message Directly or Put it Into Outbound Queue When Queue is Enabled
+// Return Message ID When Message is Sent Directly, Otherwise Return Queue Job ID
+func WhatsAppQueueSend(ctx context.Context, jid string, msg *QueueMessage) (string, int64, error) {
+	if !WhatsAppQueueEnabled {
+		msgID, err := whatsAppQueueExecute(ctx, jid, msg)
+		return msgID, 0, err
+	}
+
+	if WhatsAppSession.Get(jid) == nil {
+		return "", 0, errors.New("WhatsApp Client is not Valid")
+	}
+
+	jobID, err := WhatsAppQueueEnqueue(jid, msg)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return "", jobID, nil
+}
+
+// WhatsAppQueueEnqueue Save Message to Outbound Queue and Wake Up JID Worker
+func WhatsAppQueueEnqueue(jid string, msg *QueueMessage) (int64, error) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+
+	var jobID int64
+	err = datastore.DB.QueryRow(`INSERT INTO whatsapp_queue (jid, type, chat, payload, state, msgid, error, attempts, run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9, $9) RETURNING id`,
+		jid, msg.Type, msg.RJID, string(payload), QueueStateQueued, "", "", 0, now).Scan(&jobID)
+	if err != nil {
+		return 0, err
+	}
+
+	whatsAppQueueWake(jid)
+
+	return jobID, nil
+}
+
+func whatsAppQueueExecute(ctx context.Context, jid string, msg *QueueMessage) (string, error) {
+	switch msg.Type {
+	case QueueTypeText:
+		return WhatsAppSendText(ctx, jid, msg.RJID, msg.Text)
+
+	case QueueTypeLocation:
+		return WhatsAppSendLocation(ctx, jid, msg.RJID, msg.Latitude, msg.Longitude)
+
+	case QueueTypeLink:
+		return WhatsAppSendLink(ctx, jid, msg.RJID, msg.Text, msg.URL)
+
+	case QueueTypePoll:
+		return WhatsAppSendPoll(ctx, jid, msg.RJID, msg.Text, msg.Options, msg.IsMultiAnswer)
+
+	case QueueTypeContact:
+		return WhatsAppSendContacts(ctx, jid, msg.RJID, msg.Contacts)
+
+	case QueueTypeDocument:
+		return WhatsAppSendDocument(ctx, jid, msg.RJID, msg.FileBytes, msg.FileType, msg.Text)
+
+	case QueueTypeImage:
+		return WhatsAppSendImage(ctx, jid, msg.RJID, msg.FileBytes, msg.FileType, msg.Text, msg.IsViewOnce)
+
+	case QueueTypeAudio:
+		return WhatsAppSendAudio(ctx, jid, msg.RJID, msg.FileBytes, msg.FileType)
+
+	case QueueTypeVideo:
+		return WhatsAppSendVideo(ctx, jid, msg.RJID, msg.FileBytes, msg.FileType, msg.Text, msg.IsViewOnce)
+
+	case QueueTypeSticker:
+		return WhatsAppSendSticker(ctx, jid, msg.RJID, msg.FileBytes)
+	}
+
+	return "", errors.New("Unknown Queue Message Type " + msg.Type)
+}
+
+func whatsAppQueueWake(jid string) {
+	queueWorkers.Lock()
+	defer queueWorkers.Unlock()
+
+	worker := queueWorkers.data[jid]
+	if worker == nil {
+		worker = &queueWorker{
+			wake:    make(chan struct{}, 1),
+			stop:    make(chan struct{}),
+			limiter: whatsAppQueueLimiter(jid),
+		}
+
+		queueWorkers.data[jid] = worker
+		go worker.run(jid)
+	}
+
+	select {
+	case worker.wake <- struct{}{}:
+	default:
+	}
+}
+
+// WhatsAppQueueResume Wake Up JID Worker When There is Pending Job
+// Used When WhatsApp Client is Connected Again
+func WhatsAppQueueResume(jid string) {
+	var jobID int64
+
+	err := datastore.DB.QueryRow("SELECT id FROM whatsapp_queue WHERE jid=$1 AND state=$2 LIMIT 1",
+		jid, QueueStateQueued).Scan(&jobID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Print(nil).Error("Failed to Get WhatsApp Queue Job: " + err.Error())
+		}
+
+		return
+	}
+
+	whatsAppQueueWake(jid)
+}
+
+// WhatsAppQueueStop Stop JID Worker, Pending Job is Kept and Resumed on Next Connection
+func WhatsAppQueueStop(jid string) {
+	queueWorkers.Lock()
+	defer queueWorkers.Unlock()
+
+	worker := queueWorkers.data[jid]
+	if worker != nil {
+		close(worker.stop)
+		delete(queueWorkers.data, jid)
+	}
+}
+
+// exit Remove Worker When Queue is Empty
+// Worker is Kept When Job Was Enqueued While Checking
+func (worker *queueWorker) exit(jid string) bool {
+	queueWorkers.Lock()
+	defer queueWorkers.Unlock()
+
+	select {
+	case <-worker.wake:
+		return false
+	default:
+	}
+
+	worker.remove(jid)
+
+	return true
+}
+
+func (worker *queueWorker) remove(jid string) {
+	if queueWorkers.data[jid] == worker {
+		delete(queueWorkers.data, jid)
+	}
+}
+
+// wait Wait for Given Duration or Until Worker is Woken Up
+// Return False When Worker is Stopped
+func (worker *queueWorker) wait(waitDuration time.Duration, wake <-chan struct{}) bool {
+	timer := time.NewTimer(waitDuration)
+	defer timer.Stop()
+
+	select {
+	case <-worker.stop:
+		return false
+	case <-wake:
+	case <-timer.C:
+	}
+
+	return true
+}
+
+func (worker *queueWorker) run(jid string) {
+	for {
+		jobID, payload, attempts, runAt, err := whatsAppQueueNext(jid)
+		if err != nil {
+			log.Print(nil).Error("Failed to Get WhatsApp Queue Job: " + err.Error())
+			runAt = time.Now().Add(5 * time.Second)
+		}
+
+		// Stop Worker When There is No More Pending Job
+		if err == nil && jobID == 0 {
+			if worker.exit(jid) {
+				return
+			}
+
+			continue
+		}
+
+		// Stop Worker When WhatsApp Client is Removed
+		// Pending Job is Resumed When WhatsApp Client is Connected Again
+		if WhatsAppSession.Get(jid) == nil {
+			queueWorkers.Lock()
+			worker.remove(jid)
+			queueWorkers.Unlock()
+
+			return
+		}
+
+		// Wait Until Next Job is Due or New Job is Enqueued
+		if err != nil || runAt.After(time.Now()) {
+			if !worker.wait(time.Until(runAt), worker.wake) {
+				return
+			}
+
+			continue
+		}
+
+		// Apply Rate Limit and Randomised Jitter Before Sending
+		waitDuration := worker.limiter.Wait()
+
+		waitDuration += WhatsAppQueueJitterMin
+		if WhatsAppQueueJitterMax > WhatsAppQueueJitterMin {
+			waitDuration += time.Duration(rand.Int63n(int64(WhatsAppQueueJitterMax - WhatsAppQueueJitterMin)))
+		}
+
+		if !worker.wait(waitDuration, nil) {
+			return
+		}
+
+		worker.limiter.Take()
+
+		whatsAppQueueProcess(jid, jobID, payload, attempts)
+	}
+}
+
+func whatsAppQueueNext(jid string) (int64, string, int, time.Time, error) {
+	var jobID int64
+	var payload string
+	var attempts int
+	var runAt time.Time
+
+	err := datastore.DB.QueryRow("SELECT id, payload, attempts, run_at FROM whatsapp_queue WHERE jid=$1 AND state=$2 ORDER BY run_at, id LIMIT 1",
+		jid, QueueStateQueued).Scan(&jobID, &payload, &attempts, &runAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, "", 0, time.Time{}, nil
+	}
+
+	return jobID, payload, attempts, runAt, err
+}
+
+func whatsAppQueueProcess(jid string, jobID int64, payload string, attempts int) {
+	now := time.Now().UTC()
+
+	// Disconnected WhatsApp Client Should Not Use Up Job Attempt
+	err := WhatsAppIsClientOK(jid)
+	if err != nil {
+		whatsAppQueueDefer(jobID, err)
+		return
+	}
+
+	res, err := datastore.DB.Exec("UPDATE whatsapp_queue SET state=$1, attempts=$2, updated_at=$3 WHERE id=$4 AND state=$5",
+		QueueStateSending, attempts+1, now, jobID, QueueStateQueued)
+	if err != nil {
+		log.Print(nil).Error("Failed to Update WhatsApp Queue Job: " + err.Error())
+		return
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return
+	}
+
+	var msg QueueMessage
+	var msgID string
+
+	err = json.Unmarshal([]byte(payload), &msg)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), WhatsAppQueueSendTimeout)
+		msgID, err = whatsAppQueueExecute(ctx, jid, &msg)
+		cancel()
+	}
+
+	now = time.Now().UTC()
+
+	// WhatsApp Client Disconnected While Sending is Not Counted as Attempt
+	if err != nil && WhatsAppIsClientOK(jid) != nil {
+		_, err = datastore.DB.Exec("UPDATE whatsapp_queue SET state=$1, attempts=$2, error=$3, run_at=$4, updated_at=$5 WHERE id=$6",
+			QueueStateQueued, attempts, err.Error(), now.Add(whatsAppQueueDeferDuration), now, jobID)
+		if err != nil {
+			log.Print(nil).Error("Failed to Update WhatsApp Queue Job: " + err.Error())
+		}
+
+		return
+	}
+
+	switch {
+	case err == nil:
+		// Payload is No Longer Needed After Message is Sent
+		_, err = datastore.DB.Exec("UPDATE whatsapp_queue SET state=$1, msgid=$2, error=$3, payload=$4, updated_at=$5, sent_at=$5 WHERE id=$6",
+			QueueStateSent, msgID, "", "", now, jobID)
+		if err == nil {
+			whatsAppBulkQueueDone(jobID, QueueStateSent, msgID, "")
+		}
+
+	case attempts < WhatsAppQueueMaxRetry:
+		// Retry With Exponential Backoff
+		runAt := now.Add(time.Duration(30<<attempts) * time.Second)
+		_, err = datastore.DB.Exec("UPDATE whatsapp_queue SET state=$1, error=$2, run_at=$3, updated_at=$4 WHERE id=$5",
+			QueueStateQueued, err.Error(), runAt, now, jobID)
+
+	default:
+		errMessage := err.Error()
+
+		_, err = datastore.DB.Exec("UPDATE whatsapp_queue SET state=$1, error=$2, payload=$3, updated_at=$4 WHERE id=$5",
+			QueueStateFailed, errMessage, "", now, jobID)
+		if err == nil {
+			whatsAppBulkQueueDone(jobID, QueueStateFailed, "", errMessage)
+		}
+	}
+
+	if err != nil {
+		log.Print(nil).Error("Failed to Update WhatsApp Queue Job: " + err.Error())
+	}
+}
+
+const whatsAppQueueDeferDuration = 30 * time.Second
+
+// whatsAppQueueDefer Postpone Job Without Counting Attempt
+func whatsAppQueueDefer(jobID int64, reason error) {
+	now := time.Now().UTC()
+
+	_, err := datastore.DB.Exec("UPDATE whatsapp_queue SET error=$1, run_at=$2, updated_at=$3 WHERE id=$4 AND state=$5",
+		reason.Error(), now.Add(whatsAppQueueDeferDuration), now, jobID, QueueStateQueued)
+	if err != nil {
+		log.Print(nil).Error("Failed to Update WhatsApp Queue Job: " + err.Error())
+	}
+}
+
+// WhatsAppQueueStart Start Worker for Every JID With Pending Job
+// Job Interrupted While Sending is Marked as Failed to Prevent Duplicate Message
+func WhatsAppQueueStart() {
+	_, err := datastore.DB.Exec("UPDATE whatsapp_queue SET state=$1, error=$2, payload=$3, updated_at=$4 WHERE state=$5",
+		QueueStateFailed, "Interrupted While Sending", "", time.Now().UTC(), QueueStateSending)
+	if err != nil {
+		log.Print(nil).Error("Failed to Recover WhatsApp Queue Job: " + err.Error())
+	}
+
+	rows, err := datastore.DB.Query("SELECT DISTINCT jid FROM whatsapp_queue WHERE state=$1", QueueStateQueued)
+	if err != nil {
+		log.Print(nil).Error("Failed to Load WhatsApp Queue Job: " + err.Error())
+		return
+	}
+
+	var jids []string
+	for rows.Next() {
+		var jid string
+
+		if rows.Scan(&jid) == nil {
+			jids = append(jids, jid)
+		}
+	}
+
+	rows.Close()
+
+	for _, jid := range jids {
+		whatsAppQueueWake(jid)
+	}
+}
+
+const whatsAppQueueColumns = "id, type, chat, state, msgid, error, attempts, run_at, created_at, updated_at, sent_at"
+
+func whatsAppQueueScan(row interface{ Scan(...interface{}) error }) (*QueueJob, error) {
+	var job QueueJob
+	var sentAt sql.NullTime
+
+	err := row.Scan(&job.ID, &job.Type, &job.Chat, &job.State, &job.MsgID, &job.Error, &job.Attempts,
+		&job.RunAt, &job.CreatedAt, &job.UpdatedAt, &sentAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if sentAt.Valid {
+		job.SentAt = &sentAt.Time
+	}
+
+	return &job, nil
+}
+
+// WhatsAppQueueGet Get Outbound Queue Job State
+func WhatsAppQueueGet(jid string, jobID int64) (*QueueJob, error) {
+	job, err := whatsAppQueueScan(datastore.DB.QueryRow("SELECT "+whatsAppQueueColumns+" FROM whatsapp_queue WHERE jid=$1 AND id=$2",
+		jid, jobID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("WhatsApp Queue Job is Not Found")
+	}
+
+	return job, err
+}
+
+// WhatsAppQueueList Get Outbound Queue Jobs Ordered From The Newest Job
+// Next Page Can Be Requested Using Next Cursor as Before Cursor
+func WhatsAppQueueList(jid string, query QueueQuery) (*QueuePage, error) {
+	if query.Limit <= 0 || query.Limit > 200 {
+		query.Limit = 50
+	}
+
+	sqlQuery := "SELECT " + whatsAppQueueColumns + " FROM whatsapp_queue WHERE jid=$1"
+	sqlArgs := []interface{}{jid}
+
+	if len(query.State) > 0 {
+		sqlArgs = append(sqlArgs, query.State)
+		sqlQuery += " AND state=$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	if query.Before > 0 {
+		sqlArgs = append(sqlArgs, query.Before)
+		sqlQuery += " AND id<$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	// Query One More Row to Know if Next Page is Exist
+	sqlArgs = append(sqlArgs, query.Limit+1)
+	sqlQuery += " ORDER BY id DESC LIMIT $" + strconv.Itoa(len(sqlArgs))
+
+	rows, err := datastore.DB.Query(sqlQuery, sqlArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &QueuePage{
+		Jobs: []QueueJob{},
+	}
+
+	for rows.Next() {
+		job, err := whatsAppQueueScan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Jobs = append(page.Jobs, *job)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(page.Jobs) > query.Limit {
+		page.Jobs = page.Jobs[:query.Limit]
+		page.NextCursor = page.Jobs[query.Limit-1].ID
+	}
+
+	return page, nil
+}
+
+// WhatsAppQueuePurge Remove Finished Outbound Queue Jobs Older Than Retention
+func WhatsAppQueuePurge() error {
+	_, err := datastore.DB.Exec("DELETE FROM whatsapp_queue WHERE state IN ($1, $2) AND updated_at<$3",
+		QueueStateSent, QueueStateFailed, time.Now().UTC().Add(-WhatsAppQueueRetention))
+
+	return err
+}
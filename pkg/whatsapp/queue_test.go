@@ -0,0 +1,163 @@
+package whatsapp
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+)
+
+func queueTestJob(t *testing.T, jobID int64) (string, int, time.Time) {
+	var state string
+	var attempts int
+	var runAt time.Time
+
+	err := datastore.DB.QueryRow("SELECT state, attempts, run_at FROM whatsapp_queue WHERE id=$1", jobID).Scan(&state, &attempts, &runAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return state, attempts, runAt
+}
+
+func queueTestWorkerExist(jid string) bool {
+	queueWorkers.Lock()
+	defer queueWorkers.Unlock()
+
+	return queueWorkers.data[jid] != nil
+}
+
+func TestWhatsAppQueueWorkerStopWithoutClient(t *testing.T) {
+	jid := "queue-test-removed"
+
+	jobID, err := WhatsAppQueueEnqueue(jid, &QueueMessage{Type: QueueTypeText, RJID: "6281", Text: "Test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Worker Should Stop Since There is No WhatsApp Client for JID
+	deadline := time.Now().Add(5 * time.Second)
+	for queueTestWorkerExist(jid) {
+		if time.Now().After(deadline) {
+			t.Fatal("queue worker is still running without WhatsApp client")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	state, attempts, _ := queueTestJob(t, jobID)
+	if state != QueueStateQueued || attempts != 0 {
+		t.Errorf("job state = %s attempts = %d, want %s attempts = 0", state, attempts, QueueStateQueued)
+	}
+}
+
+func TestWhatsAppQueueProcessDisconnected(t *testing.T) {
+	jid := "queue-test-disconnected"
+
+	// Registered WhatsApp Client Without Connection
+	client := WhatsAppSession.Create(jid, func() *whatsmeow.Client {
+		return &whatsmeow.Client{}
+	})
+	defer WhatsAppSession.Remove(jid, client)
+
+	jobID, err := WhatsAppQueueEnqueue(jid, &QueueMessage{Type: QueueTypeText, RJID: "6281", Text: "Test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	WhatsAppQueueStop(jid)
+
+	whatsAppQueueProcess(jid, jobID, `{"type":"text","rjid":"6281","text":"Test"}`, 0)
+
+	// Job Should Be Postponed Without Using Up Attempt
+	state, attempts, runAt := queueTestJob(t, jobID)
+	if state != QueueStateQueued || attempts != 0 {
+		t.Errorf("job state = %s attempts = %d, want %s attempts = 0", state, attempts, QueueStateQueued)
+	}
+
+	if !runAt.After(time.Now()) {
+		t.Errorf("run_at = %v, want postponed job", runAt)
+	}
+}
+
+func queueTestJobError(t *testing.T, jobID int64) string {
+	var jobError string
+
+	err := datastore.DB.QueryRow("SELECT error FROM whatsapp_queue WHERE id=$1", jobID).Scan(&jobError)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return jobError
+}
+
+func TestWhatsAppQueueRateLimitAfterIdle(t *testing.T) {
+	jid := "queue-test-ratelimit"
+
+	// Remove Job Left by Previous Run
+	_, err := datastore.DB.Exec("DELETE FROM whatsapp_queue WHERE jid=$1", jid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Allow Only One Message per Minute
+	queueLimiters.Lock()
+	queueLimiters.data[jid] = &queueLimiter{
+		minute: newQueueBucket(1, time.Minute),
+		hour:   newQueueBucket(WhatsAppQueueRatePerHour, time.Hour),
+	}
+	queueLimiters.Unlock()
+
+	// Registered WhatsApp Client Without Connection
+	// So Processed Job is Postponed With Error Instead of Sent
+	client := WhatsAppSession.Create(jid, func() *whatsmeow.Client {
+		return &whatsmeow.Client{}
+	})
+	defer WhatsAppSession.Remove(jid, client)
+	defer WhatsAppQueueStop(jid)
+
+	firstJobID, err := WhatsAppQueueEnqueue(jid, &QueueMessage{Type: QueueTypeText, RJID: "6281", Text: "Test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(queueTestJobError(t, firstJobID)) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("first job is not processed")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Drain The Queue So Worker Exit
+	_, err = datastore.DB.Exec("UPDATE whatsapp_queue SET state=$1 WHERE id=$2", QueueStateSent, firstJobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	whatsAppQueueWake(jid)
+
+	deadline = time.Now().Add(5 * time.Second)
+	for queueTestWorkerExist(jid) {
+		if time.Now().After(deadline) {
+			t.Fatal("queue worker is still running with empty queue")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// New Worker Should Still Wait for Per Minute Token Used by First Job
+	secondJobID, err := WhatsAppQueueEnqueue(jid, &QueueMessage{Type: QueueTypeText, RJID: "6281", Text: "Test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(500 * time.Millisecond)
+
+	if jobError := queueTestJobError(t, secondJobID); len(jobError) != 0 {
+		t.Errorf("second job is processed without waiting for rate limit: %s", jobError)
+	}
+}
@@ -0,0 +1,211 @@
+package whatsapp
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+)
+
+const (
+	HistoryStatusDelivered = "delivered"
+	HistoryStatusRead      = "read"
+	HistoryStatusPlayed    = "played"
+)
+
+type ReceiptParticipant struct {
+	JID       string    `json:"jid"`
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type ReceiptStatus struct {
+	MsgID        string               `json:"msgid"`
+	Chat         string               `json:"chat"`
+	IsGroup      bool                 `json:"is_group"`
+	Status       string               `json:"status"`
+	SentAt       *time.Time           `json:"sent_at,omitempty"`
+	Participants []ReceiptParticipant `json:"participants"`
+}
+
+// Status Rank Used to Make Sure Status Only Moving Forward
+// Receipt Can Be Received Out of Order
+var whatsAppReceiptRank = map[string]int{
+	HistoryStatusFailed:    0,
+	HistoryStatusSent:      1,
+	HistoryStatusDelivered: 2,
+	HistoryStatusRead:      3,
+	HistoryStatusPlayed:    4,
+}
+
+var whatsAppReceiptMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE whatsapp_receipt (
+				jid         TEXT NOT NULL,
+				chat        TEXT NOT NULL,
+				msgid       TEXT NOT NULL,
+				participant TEXT NOT NULL,
+				status      TEXT NOT NULL,
+				status_rank INTEGER NOT NULL,
+				timestamp   TIMESTAMP NOT NULL,
+				PRIMARY KEY (jid, chat, msgid, participant)
+			)`,
+			`CREATE INDEX whatsapp_receipt_msgid_idx ON whatsapp_receipt (jid, msgid)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE whatsapp_receipt (
+				jid         TEXT NOT NULL,
+				chat        TEXT NOT NULL,
+				msgid       TEXT NOT NULL,
+				participant TEXT NOT NULL,
+				status      TEXT NOT NULL,
+				status_rank INTEGER NOT NULL,
+				timestamp   TIMESTAMPTZ NOT NULL,
+				PRIMARY KEY (jid, chat, msgid, participant)
+			)`,
+			`CREATE INDEX whatsapp_receipt_msgid_idx ON whatsapp_receipt (jid, msgid)`,
+		},
+	},
+}
+
+func init() {
+	datastore.Register("whatsapp_receipt", whatsAppReceiptMigrations)
+}
+
+func whatsAppReceiptHandle(jid string, evt *events.Receipt) {
+	var status string
+
+	switch evt.Type {
+	case types.ReceiptTypeDelivered:
+		status = HistoryStatusDelivered
+	case types.ReceiptTypeRead:
+		status = HistoryStatusRead
+	case types.ReceiptTypePlayed:
+		status = HistoryStatusPlayed
+	case types.ReceiptTypeServerError:
+		status = HistoryStatusFailed
+	default:
+		// Receipt From Own Devices and Retry Receipt Are Not Tracked
+		return
+	}
+
+	chat := evt.Chat.ToNonAD().String()
+	participant := evt.Sender.ToNonAD().String()
+	now := time.Now().UTC()
+
+	for _, msgID := range evt.MessageIDs {
+		var err error
+
+		if status == HistoryStatusFailed {
+			// Server Error Means Message is Not Sent Properly
+			_, err = datastore.DB.Exec("UPDATE whatsapp_message SET status=$1, updated_at=$2 WHERE jid=$3 AND chat=$4 AND msgid=$5 AND status=$6",
+				status, now, jid, chat, msgID, HistoryStatusSent)
+		} else {
+			_, err = datastore.DB.Exec(`INSERT INTO whatsapp_receipt (jid, chat, msgid, participant, status, status_rank, timestamp)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+				ON CONFLICT (jid, chat, msgid, participant) DO UPDATE SET status=excluded.status, status_rank=excluded.status_rank, timestamp=excluded.timestamp
+				WHERE whatsapp_receipt.status_rank < excluded.status_rank`,
+				jid, chat, msgID, participant, status, whatsAppReceiptRank[status], evt.Timestamp.UTC())
+			if err == nil {
+				err = whatsAppReceiptUpdateMessage(jid, chat, msgID, status, now)
+			}
+		}
+
+		if err != nil {
+			log.Print(nil).Error("Failed to Save WhatsApp Message Receipt: " + err.Error())
+		}
+	}
+}
+
+func whatsAppReceiptUpdateMessage(jid string, chat string, msgID string, status string, now time.Time) error {
+	sqlQuery := "UPDATE whatsapp_message SET status=$1, updated_at=$2 WHERE jid=$3 AND chat=$4 AND msgid=$5 AND direction=$6 AND status IN ("
+	sqlArgs := []interface{}{status, now, jid, chat, msgID, HistoryDirectionOutgoing}
+
+	// Only Update Message Status That is Lower Than Receipt Status
+	for lowerStatus, lowerRank := range whatsAppReceiptRank {
+		if lowerRank > 0 && lowerRank < whatsAppReceiptRank[status] {
+			sqlArgs = append(sqlArgs, lowerStatus)
+			if len(sqlArgs) > 7 {
+				sqlQuery += ", "
+			}
+			sqlQuery += "$" + strconv.Itoa(len(sqlArgs))
+		}
+	}
+
+	if len(sqlArgs) == 6 {
+		return nil
+	}
+
+	_, err := datastore.DB.Exec(sqlQuery+")", sqlArgs...)
+	return err
+}
+
+// WhatsAppReceiptStatusGet Get Delivery Status of Sent Message
+// Group Message Will Have Status for Every Participant
+func WhatsAppReceiptStatusGet(jid string, msgID string) (*ReceiptStatus, error) {
+	result := &ReceiptStatus{
+		MsgID:        msgID,
+		Participants: []ReceiptParticipant{},
+	}
+
+	var sentAt time.Time
+
+	err := datastore.DB.QueryRow(`SELECT chat, is_group, status, timestamp FROM whatsapp_message
+		WHERE jid=$1 AND msgid=$2 AND direction=$3 ORDER BY id DESC LIMIT 1`, jid, msgID, HistoryDirectionOutgoing).
+		Scan(&result.Chat, &result.IsGroup, &result.Status, &sentAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if err == nil {
+		result.SentAt = &sentAt
+	}
+
+	rows, err := datastore.DB.Query(`SELECT chat, participant, status, timestamp FROM whatsapp_receipt
+		WHERE jid=$1 AND msgid=$2 ORDER BY participant`, jid, msgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var chat string
+		var participant ReceiptParticipant
+
+		err = rows.Scan(&chat, &participant.JID, &participant.Status, &participant.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(result.Chat) == 0 {
+			result.Chat = chat
+			chatJID, _ := types.ParseJID(chat)
+			result.IsGroup = chatJID.Server == types.GroupServer
+		}
+
+		// Overall Status is The Highest Status Reached
+		if len(result.Status) == 0 || whatsAppReceiptRank[participant.Status] > whatsAppReceiptRank[result.Status] {
+			result.Status = participant.Status
+		}
+
+		result.Participants = append(result.Participants, participant)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Chat) == 0 {
+		return nil, errors.New("WhatsApp Message is Not Found")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,215 @@
+package whatsapp
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
+)
+
+type RegisteredInfo struct {
+	Query        string `json:"query"`
+	IsRegistered bool   `json:"is_registered"`
+	JID          string `json:"jid,omitempty"`
+	IsBusiness   bool   `json:"is_business"`
+	BusinessName string `json:"business_name,omitempty"`
+}
+
+type registeredCacheItem struct {
+	info      RegisteredInfo
+	expiredAt time.Time
+}
+
+var (
+	WhatsAppRegisteredCacheTTL time.Duration
+	WhatsAppRegisteredBatchMax int
+	WhatsAppDefaultCountryCode string
+)
+
+var registeredCache = struct {
+	sync.RWMutex
+	data map[string]registeredCacheItem
+}{
+	data: make(map[string]registeredCacheItem),
+}
+
+func init() {
+	var err error
+
+	registeredCacheTTL, err := env.GetEnvInt("WHATSAPP_REGISTERED_CACHE_TTL")
+	if err != nil || registeredCacheTTL < 0 {
+		registeredCacheTTL = 3600
+	}
+
+	WhatsAppRegisteredCacheTTL = time.Duration(registeredCacheTTL) * time.Second
+
+	WhatsAppRegisteredBatchMax, err = env.GetEnvInt("WHATSAPP_REGISTERED_BATCH_MAX")
+	if err != nil || WhatsAppRegisteredBatchMax <= 0 {
+		WhatsAppRegisteredBatchMax = 100
+	}
+
+	WhatsAppDefaultCountryCode, _ = env.GetEnvString("WHATSAPP_DEFAULT_COUNTRY_CODE")
+	WhatsAppDefaultCountryCode = strings.TrimPrefix(strings.TrimSpace(WhatsAppDefaultCountryCode), "+")
+}
+
+// WhatsAppNormalizePhone Normalize Phone Number to International Format Without '+' Symbol
+// Space, Dash, Dot and Parentheses Are Removed, Leading '00' is Treated as International Prefix
+// and Other Leading Zero is Replaced With Country Code
+func WhatsAppNormalizePhone(phone string, countryCode string) (string, error) {
+	phone = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+
+		return r
+	}, strings.TrimSpace(phone))
+
+	countryCode = strings.TrimPrefix(strings.TrimSpace(countryCode), "+")
+
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		phone = phone[1:]
+
+	case strings.HasPrefix(phone, "00"):
+		phone = phone[2:]
+
+	case strings.HasPrefix(phone, "0"):
+		if len(countryCode) == 0 {
+			return "", errors.New("Phone Number Without Country Code Needs Default Country Code")
+		}
+
+		phone = countryCode + phone[1:]
+	}
+
+	if _, err := strconv.ParseUint(phone, 10, 64); err != nil {
+		return "", errors.New("Phone Number Should Only Contain Digits")
+	}
+
+	// E.164 Phone Number is at Most 15 Digits
+	if len(phone) < 7 || len(phone) > 15 {
+		return "", errors.New("Phone Number Should be 7 to 15 Digits")
+	}
+
+	return phone, nil
+}
+
+// WhatsAppRegisteredCheck Check Multiple Phone Numbers Using Single IsOnWhatsApp Call
+// Phone Numbers Should Only Contain Digits, Result is Mapped by Phone Number
+// Result is Cached So Repeated Check Does Not Hit WhatsApp Until Cache is Expired
+func WhatsAppRegisteredCheck(jid string, phones []string) (map[string]RegisteredInfo, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		result := make(map[string]RegisteredInfo)
+		now := time.Now()
+
+		var queries []string
+
+		registeredCache.RLock()
+		for _, phone := range phones {
+			if _, isExist := result[phone]; isExist {
+				continue
+			}
+
+			item, isExist := registeredCache.data[phone]
+			if isExist && now.Before(item.expiredAt) {
+				result[phone] = item.info
+				continue
+			}
+
+			result[phone] = RegisteredInfo{Query: phone}
+			queries = append(queries, "+"+phone)
+		}
+		registeredCache.RUnlock()
+
+		if len(queries) == 0 {
+			return result, nil
+		}
+
+		// Make Sure WhatsApp Client is OK
+		err := WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		infos, err := client.IsOnWhatsApp(queries)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range infos {
+			phone := WhatsAppDecomposeJID(info.Query)
+			if _, isExist := result[phone]; !isExist {
+				continue
+			}
+
+			registeredInfo := RegisteredInfo{
+				Query:        phone,
+				IsRegistered: info.IsIn,
+			}
+
+			if info.IsIn {
+				registeredInfo.JID = info.JID.String()
+			}
+
+			if info.VerifiedName != nil {
+				registeredInfo.IsBusiness = true
+
+				if info.VerifiedName.Details != nil {
+					registeredInfo.BusinessName = info.VerifiedName.Details.GetVerifiedName()
+				}
+			}
+
+			result[phone] = registeredInfo
+		}
+
+		// Phone Number Without Response is Treated as Not Registered
+		registeredCache.Lock()
+		for _, query := range queries {
+			phone := query[1:]
+
+			registeredCache.data[phone] = registeredCacheItem{
+				info:      result[phone],
+				expiredAt: now.Add(WhatsAppRegisteredCacheTTL),
+			}
+		}
+		registeredCache.Unlock()
+
+		return result, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppRegisteredPurge Remove Expired Registered Check Result From Cache
+func WhatsAppRegisteredPurge() {
+	now := time.Now()
+
+	registeredCache.Lock()
+	defer registeredCache.Unlock()
+
+	for phone, item := range registeredCache.data {
+		if !now.Before(item.expiredAt) {
+			delete(registeredCache.data, phone)
+		}
+	}
+}
+
+func whatsAppRegisteredJID(info RegisteredInfo) types.JID {
+	if !info.IsRegistered {
+		return types.EmptyJID
+	}
+
+	remoteJID, err := types.ParseJID(info.JID)
+	if err != nil {
+		return types.EmptyJID
+	}
+
+	return remoteJID
+}
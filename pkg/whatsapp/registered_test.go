@@ -0,0 +1,48 @@
+package whatsapp
+
+import (
+	"testing"
+)
+
+func TestWhatsAppNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone       string
+		countryCode string
+		want        string
+	}{
+		{"+62 812-3456-7890", "", "6281234567890"},
+		{"(0812) 3456.7890", "62", "6281234567890"},
+		{"0812 3456 7890", "+62", "6281234567890"},
+		{"6281234567890", "", "6281234567890"},
+		{"0044 20 7946 0958", "62", "442079460958"},
+		{"00 1 415 555 2671", "", "14155552671"},
+	}
+
+	for _, test := range tests {
+		got, err := WhatsAppNormalizePhone(test.phone, test.countryCode)
+		if err != nil {
+			t.Errorf("WhatsAppNormalizePhone(%q, %q) error = %v", test.phone, test.countryCode, err)
+			continue
+		}
+
+		if got != test.want {
+			t.Errorf("WhatsAppNormalizePhone(%q, %q) = %q, want %q", test.phone, test.countryCode, got, test.want)
+		}
+	}
+
+	invalids := []struct {
+		phone       string
+		countryCode string
+	}{
+		{"0812 3456 7890", ""},
+		{"+62 812 ABC", ""},
+		{"+123", ""},
+		{"+1234567890123456", ""},
+	}
+
+	for _, invalid := range invalids {
+		if got, err := WhatsAppNormalizePhone(invalid.phone, invalid.countryCode); err == nil {
+			t.Errorf("WhatsAppNormalizePhone(%q, %q) = %q, want error", invalid.phone, invalid.countryCode, got)
+		}
+	}
+}
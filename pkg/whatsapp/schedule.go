@@ -0,0 +1,583 @@
+package whatsapp
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// Embed Timezone Database So Schedule Timezone Works on Minimal Container Image
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
+)
+
+const (
+	ScheduleStateActive    = "active"
+	ScheduleStatePaused    = "paused"
+	ScheduleStateCompleted = "completed"
+)
+
+type Schedule struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Type      string     `json:"type"`
+	Chat      string     `json:"chat"`
+	Cron      string     `json:"cron,omitempty"`
+	RunAt     *time.Time `json:"run_at,omitempty"`
+	Timezone  string     `json:"timezone"`
+	State     string     `json:"state"`
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type ScheduleRun struct {
+	ID        int64     `json:"id"`
+	MsgID     string    `json:"msgid,omitempty"`
+	JobID     int64     `json:"job_id,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ScheduleRunQuery struct {
+	Before int64
+	Limit  int
+}
+
+type ScheduleRunPage struct {
+	Runs       []ScheduleRun `json:"runs"`
+	NextCursor int64         `json:"next_cursor,omitempty"`
+}
+
+// scheduleOnce Cron Schedule That Only Activated Once at Given Time
+type scheduleOnce struct {
+	at time.Time
+}
+
+func (schedule scheduleOnce) Next(t time.Time) time.Time {
+	if t.Before(schedule.at) {
+		return schedule.at
+	}
+
+	return time.Time{}
+}
+
+var ErrScheduleNotFound = errors.New("Schedule is Not Found")
+
+var scheduleEntries = struct {
+	sync.Mutex
+	cron *cron.Cron
+	data map[int64]cron.EntryID
+}{
+	data: make(map[int64]cron.EntryID),
+}
+
+var whatsAppScheduleMigrations = []datastore.Migration{
+	{
+		SQLite: []string{
+			`CREATE TABLE whatsapp_schedule (
+				id         INTEGER PRIMARY KEY AUTOINCREMENT,
+				jid        TEXT NOT NULL,
+				name       TEXT NOT NULL,
+				type       TEXT NOT NULL,
+				chat       TEXT NOT NULL,
+				payload    TEXT NOT NULL,
+				cron       TEXT NOT NULL,
+				run_at     TIMESTAMP,
+				timezone   TEXT NOT NULL,
+				state      TEXT NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL
+			)`,
+			`CREATE INDEX whatsapp_schedule_jid_idx ON whatsapp_schedule (jid)`,
+			`CREATE TABLE whatsapp_schedule_run (
+				id          INTEGER PRIMARY KEY AUTOINCREMENT,
+				schedule_id INTEGER NOT NULL,
+				msgid       TEXT NOT NULL,
+				job_id      INTEGER NOT NULL,
+				error       TEXT NOT NULL,
+				created_at  TIMESTAMP NOT NULL
+			)`,
+			`CREATE INDEX whatsapp_schedule_run_idx ON whatsapp_schedule_run (schedule_id, id)`,
+		},
+		Postgres: []string{
+			`CREATE TABLE whatsapp_schedule (
+				id         BIGSERIAL PRIMARY KEY,
+				jid        TEXT NOT NULL,
+				name       TEXT NOT NULL,
+				type       TEXT NOT NULL,
+				chat       TEXT NOT NULL,
+				payload    TEXT NOT NULL,
+				cron       TEXT NOT NULL,
+				run_at     TIMESTAMPTZ,
+				timezone   TEXT NOT NULL,
+				state      TEXT NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL,
+				updated_at TIMESTAMPTZ NOT NULL
+			)`,
+			`CREATE INDEX whatsapp_schedule_jid_idx ON whatsapp_schedule (jid)`,
+			`CREATE TABLE whatsapp_schedule_run (
+				id          BIGSERIAL PRIMARY KEY,
+				schedule_id BIGINT NOT NULL,
+				msgid       TEXT NOT NULL,
+				job_id      BIGINT NOT NULL,
+				error       TEXT NOT NULL,
+				created_at  TIMESTAMPTZ NOT NULL
+			)`,
+			`CREATE INDEX whatsapp_schedule_run_idx ON whatsapp_schedule_run (schedule_id, id)`,
+		},
+	},
+}
+
+func init() {
+	datastore.Register("whatsapp_schedule", whatsAppScheduleMigrations)
+}
+
+// WhatsAppScheduleParseTime Parse Absolute Schedule Time
+// Time Without Offset is Parsed in Given Timezone
+func WhatsAppScheduleParseTime(value string, timezone string) (time.Time, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, errors.New("Invalid Timezone " + timezone)
+	}
+
+	runAt, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return runAt.UTC(), nil
+	}
+
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02 15:04", "2006-01-02T15:04"} {
+		runAt, err = time.ParseInLocation(layout, value, location)
+		if err == nil {
+			return runAt.UTC(), nil
+		}
+	}
+
+	return time.Time{}, errors.New("Invalid Schedule Time, Use RFC3339 or YYYY-MM-DD HH:MM:SS Format")
+}
+
+// whatsAppScheduleParseCron Parse Standard Cron Expression in Given Timezone
+func whatsAppScheduleParseCron(expression string, timezone string) (cron.Schedule, error) {
+	_, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.New("Invalid Timezone " + timezone)
+	}
+
+	if strings.HasPrefix(expression, "TZ=") || strings.HasPrefix(expression, "CRON_TZ=") {
+		return nil, errors.New("Invalid Cron Expression, Use Timezone Parameter Instead of TZ Prefix")
+	}
+
+	schedule, err := cron.ParseStandard("CRON_TZ=" + timezone + " " + expression)
+	if err != nil {
+		return nil, errors.New("Invalid Cron Expression: " + err.Error())
+	}
+
+	// Standard Cron Expression Has Minute Precision
+	// But Interval Descriptor Can Still Be Shorter
+	if delaySchedule, ok := schedule.(cron.ConstantDelaySchedule); ok && delaySchedule.Delay < time.Minute {
+		return nil, errors.New("Invalid Cron Expression, Interval Should be at Least One Minute")
+	}
+
+	return schedule, nil
+}
+
+// WhatsAppScheduleCreate Create Persistent Schedule to Send Message at Absolute Time or on Cron Expression
+func WhatsAppScheduleCreate(jid string, name string, msg *QueueMessage, expression string, runAt *time.Time, timezone string) (*Schedule, error) {
+	if (len(expression) == 0) == (runAt == nil) {
+		return nil, errors.New("Schedule Should Have Either Time or Cron Expression")
+	}
+
+	if len(expression) > 0 {
+		_, err := whatsAppScheduleParseCron(expression, timezone)
+		if err != nil {
+			return nil, err
+		}
+	} else if runAt.Before(time.Now()) {
+		return nil, errors.New("Schedule Time Should be in The Future")
+	}
+
+	if WhatsAppSession.Get(jid) == nil {
+		return nil, errors.New("WhatsApp Client is not Valid")
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	var scheduleRunAt sql.NullTime
+	if runAt != nil {
+		scheduleRunAt = sql.NullTime{Time: runAt.UTC(), Valid: true}
+	}
+
+	var id int64
+	err = datastore.DB.QueryRow(`INSERT INTO whatsapp_schedule (jid, name, type, chat, payload, cron, run_at, timezone, state, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10) RETURNING id`,
+		jid, strings.TrimSpace(name), msg.Type, msg.RJID, string(payload), expression, scheduleRunAt, timezone, ScheduleStateActive, now).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := WhatsAppScheduleGet(jid, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = whatsAppScheduleAdd(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return WhatsAppScheduleGet(jid, id)
+}
+
+// whatsAppScheduleAdd Register Active Schedule to Cron Scheduler
+// Absolute Schedule Missed While Service is Down is Activated Immediately
+func whatsAppScheduleAdd(schedule *Schedule) error {
+	scheduleEntries.Lock()
+	defer scheduleEntries.Unlock()
+
+	if scheduleEntries.cron == nil {
+		return errors.New("Schedule Runner is Not Started")
+	}
+
+	if _, isExist := scheduleEntries.data[schedule.ID]; isExist {
+		return nil
+	}
+
+	var cronSchedule cron.Schedule
+
+	if len(schedule.Cron) > 0 {
+		var err error
+
+		cronSchedule, err = whatsAppScheduleParseCron(schedule.Cron, schedule.Timezone)
+		if err != nil {
+			return err
+		}
+	} else {
+		runAt := *schedule.RunAt
+		if !runAt.After(time.Now()) {
+			runAt = time.Now().Add(time.Second)
+		}
+
+		cronSchedule = scheduleOnce{at: runAt}
+	}
+
+	// Job Added Using Schedule Does Not Use Cron Chain
+	// So Skip Activation When Previous One is Still Running Here
+	id := schedule.ID
+	scheduleEntries.data[id] = scheduleEntries.cron.Schedule(cronSchedule, cron.NewChain(
+		cron.Recover(cron.DiscardLogger),
+		cron.SkipIfStillRunning(cron.DiscardLogger),
+	).Then(cron.FuncJob(func() {
+		whatsAppScheduleExecute(id)
+	})))
+
+	return nil
+}
+
+func whatsAppScheduleRemove(id int64) {
+	scheduleEntries.Lock()
+	defer scheduleEntries.Unlock()
+
+	if entryID, isExist := scheduleEntries.data[id]; isExist {
+		if scheduleEntries.cron != nil {
+			scheduleEntries.cron.Remove(entryID)
+		}
+
+		delete(scheduleEntries.data, id)
+	}
+}
+
+func whatsAppScheduleNextRun(id int64) *time.Time {
+	scheduleEntries.Lock()
+	defer scheduleEntries.Unlock()
+
+	entryID, isExist := scheduleEntries.data[id]
+	if !isExist || scheduleEntries.cron == nil {
+		return nil
+	}
+
+	entry := scheduleEntries.cron.Entry(entryID)
+	if !entry.Valid() || entry.Next.IsZero() {
+		return nil
+	}
+
+	nextRunAt := entry.Next.UTC()
+	return &nextRunAt
+}
+
+func whatsAppScheduleExecute(id int64) {
+	var jid, payload, expression, state string
+
+	err := datastore.DB.QueryRow("SELECT jid, payload, cron, state FROM whatsapp_schedule WHERE id=$1", id).
+		Scan(&jid, &payload, &expression, &state)
+	if err != nil || state != ScheduleStateActive {
+		whatsAppScheduleRemove(id)
+		return
+	}
+
+	var msg QueueMessage
+	var msgID string
+	var jobID int64
+
+	err = json.Unmarshal([]byte(payload), &msg)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), WhatsAppQueueSendTimeout)
+		msgID, jobID, err = WhatsAppQueueSend(ctx, jid, &msg)
+		cancel()
+	}
+
+	var runError string
+	if err != nil {
+		runError = err.Error()
+		log.Print(nil).Error("Failed to Run WhatsApp Schedule " + strconv.FormatInt(id, 10) + ": " + runError)
+	}
+
+	now := time.Now().UTC()
+
+	_, err = datastore.DB.Exec("INSERT INTO whatsapp_schedule_run (schedule_id, msgid, job_id, error, created_at) VALUES ($1, $2, $3, $4, $5)",
+		id, msgID, jobID, runError, now)
+	if err != nil {
+		log.Print(nil).Error("Failed to Save WhatsApp Schedule Run: " + err.Error())
+	}
+
+	// Absolute Schedule is Completed After Its Only Run
+	if len(expression) == 0 {
+		whatsAppScheduleRemove(id)
+
+		_, err = datastore.DB.Exec("UPDATE whatsapp_schedule SET state=$1, updated_at=$2 WHERE id=$3",
+			ScheduleStateCompleted, now, id)
+		if err != nil {
+			log.Print(nil).Error("Failed to Update WhatsApp Schedule: " + err.Error())
+		}
+	}
+}
+
+// WhatsAppScheduleStart Register Every Active Schedule From Datastore to Cron Scheduler
+func WhatsAppScheduleStart(c *cron.Cron) {
+	scheduleEntries.Lock()
+	scheduleEntries.cron = c
+	scheduleEntries.Unlock()
+
+	rows, err := datastore.DB.Query("SELECT "+whatsAppScheduleColumns+" FROM whatsapp_schedule WHERE state=$1", ScheduleStateActive)
+	if err != nil {
+		log.Print(nil).Error("Failed to Load WhatsApp Schedule: " + err.Error())
+		return
+	}
+
+	var schedules []*Schedule
+	for rows.Next() {
+		schedule, err := whatsAppScheduleScan(rows)
+		if err != nil {
+			log.Print(nil).Error("Failed to Load WhatsApp Schedule: " + err.Error())
+			continue
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	rows.Close()
+
+	for _, schedule := range schedules {
+		err = whatsAppScheduleAdd(schedule)
+		if err != nil {
+			log.Print(nil).Error("Failed to Start WhatsApp Schedule " + strconv.FormatInt(schedule.ID, 10) + ": " + err.Error())
+		}
+	}
+}
+
+const whatsAppScheduleColumns = "id, name, type, chat, cron, run_at, timezone, state, created_at, updated_at"
+
+func whatsAppScheduleScan(row interface{ Scan(...interface{}) error }) (*Schedule, error) {
+	var schedule Schedule
+	var runAt sql.NullTime
+
+	err := row.Scan(&schedule.ID, &schedule.Name, &schedule.Type, &schedule.Chat, &schedule.Cron, &runAt,
+		&schedule.Timezone, &schedule.State, &schedule.CreatedAt, &schedule.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if runAt.Valid {
+		schedule.RunAt = &runAt.Time
+	}
+
+	if schedule.State == ScheduleStateActive {
+		schedule.NextRunAt = whatsAppScheduleNextRun(schedule.ID)
+	}
+
+	return &schedule, nil
+}
+
+// WhatsAppScheduleGet Get Schedule Owned by JID
+func WhatsAppScheduleGet(jid string, id int64) (*Schedule, error) {
+	schedule, err := whatsAppScheduleScan(datastore.DB.QueryRow("SELECT "+whatsAppScheduleColumns+" FROM whatsapp_schedule WHERE jid=$1 AND id=$2",
+		jid, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrScheduleNotFound
+	}
+
+	return schedule, err
+}
+
+// WhatsAppScheduleList Get Every Schedule Owned by JID Ordered From The Newest
+func WhatsAppScheduleList(jid string, state string) ([]Schedule, error) {
+	sqlQuery := "SELECT " + whatsAppScheduleColumns + " FROM whatsapp_schedule WHERE jid=$1"
+	sqlArgs := []interface{}{jid}
+
+	if len(state) > 0 {
+		sqlArgs = append(sqlArgs, state)
+		sqlQuery += " AND state=$2"
+	}
+
+	rows, err := datastore.DB.Query(sqlQuery+" ORDER BY id DESC", sqlArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := []Schedule{}
+	for rows.Next() {
+		schedule, err := whatsAppScheduleScan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		schedules = append(schedules, *schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+// WhatsAppSchedulePause Pause Active Schedule
+func WhatsAppSchedulePause(jid string, id int64) (*Schedule, error) {
+	schedule, err := WhatsAppScheduleGet(jid, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.State != ScheduleStateActive {
+		return nil, errors.New("Schedule is Not Active")
+	}
+
+	whatsAppScheduleRemove(id)
+
+	_, err = datastore.DB.Exec("UPDATE whatsapp_schedule SET state=$1, updated_at=$2 WHERE id=$3",
+		ScheduleStatePaused, time.Now().UTC(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return WhatsAppScheduleGet(jid, id)
+}
+
+// WhatsAppScheduleResume Resume Paused Schedule
+func WhatsAppScheduleResume(jid string, id int64) (*Schedule, error) {
+	schedule, err := WhatsAppScheduleGet(jid, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.State != ScheduleStatePaused {
+		return nil, errors.New("Schedule is Not Paused")
+	}
+
+	_, err = datastore.DB.Exec("UPDATE whatsapp_schedule SET state=$1, updated_at=$2 WHERE id=$3",
+		ScheduleStateActive, time.Now().UTC(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = whatsAppScheduleAdd(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return WhatsAppScheduleGet(jid, id)
+}
+
+// WhatsAppScheduleDelete Delete Schedule and Its Execution History
+func WhatsAppScheduleDelete(jid string, id int64) error {
+	res, err := datastore.DB.Exec("DELETE FROM whatsapp_schedule WHERE jid=$1 AND id=$2", jid, id)
+	if err != nil {
+		return err
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return ErrScheduleNotFound
+	}
+
+	whatsAppScheduleRemove(id)
+
+	_, err = datastore.DB.Exec("DELETE FROM whatsapp_schedule_run WHERE schedule_id=$1", id)
+	return err
+}
+
+// WhatsAppScheduleRuns Get Schedule Execution History Ordered From The Newest Run
+// Next Page Can Be Requested Using Next Cursor as Before Cursor
+func WhatsAppScheduleRuns(jid string, id int64, query ScheduleRunQuery) (*ScheduleRunPage, error) {
+	_, err := WhatsAppScheduleGet(jid, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Limit <= 0 || query.Limit > 200 {
+		query.Limit = 50
+	}
+
+	sqlQuery := "SELECT id, msgid, job_id, error, created_at FROM whatsapp_schedule_run WHERE schedule_id=$1"
+	sqlArgs := []interface{}{id}
+
+	if query.Before > 0 {
+		sqlArgs = append(sqlArgs, query.Before)
+		sqlQuery += " AND id<$" + strconv.Itoa(len(sqlArgs))
+	}
+
+	// Query One More Row to Know if Next Page is Exist
+	sqlArgs = append(sqlArgs, query.Limit+1)
+	sqlQuery += " ORDER BY id DESC LIMIT $" + strconv.Itoa(len(sqlArgs))
+
+	rows, err := datastore.DB.Query(sqlQuery, sqlArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &ScheduleRunPage{
+		Runs: []ScheduleRun{},
+	}
+
+	for rows.Next() {
+		var run ScheduleRun
+
+		err = rows.Scan(&run.ID, &run.MsgID, &run.JobID, &run.Error, &run.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Runs = append(page.Runs, run)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(page.Runs) > query.Limit {
+		page.Runs = page.Runs[:query.Limit]
+		page.NextCursor = page.Runs[query.Limit-1].ID
+	}
+
+	return page, nil
+}
@@ -0,0 +1,32 @@
+package whatsapp
+
+import (
+	"testing"
+)
+
+func TestWhatsAppScheduleParseCron(t *testing.T) {
+	tests := map[string]bool{
+		"*/5 * * * *":         true,
+		"0 9 * * 1-5":         true,
+		"@daily":              true,
+		"@every 1m":           true,
+		"@every 1h30m":        true,
+		"@every 1s":           false,
+		"@every 59s":          false,
+		"0 * * * * *":         false,
+		"CRON_TZ=UTC * * * *": false,
+		"invalid":             false,
+	}
+
+	for expression, valid := range tests {
+		_, err := whatsAppScheduleParseCron(expression, "Asia/Jakarta")
+		if (err == nil) != valid {
+			t.Errorf("whatsAppScheduleParseCron(%q) err = %v, want valid = %v", expression, err, valid)
+		}
+	}
+
+	_, err := whatsAppScheduleParseCron("* * * * *", "Invalid/Timezone")
+	if err == nil {
+		t.Error("invalid timezone is accepted")
+	}
+}
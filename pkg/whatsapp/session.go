@@ -0,0 +1,196 @@
+package whatsapp
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+)
+
+type SessionStatus struct {
+	JID                  string     `json:"jid"`
+	IsConnected          bool       `json:"is_connected"`
+	IsLoggedIn           bool       `json:"is_logged_in"`
+	DeviceJID            string     `json:"device_jid,omitempty"`
+	PushName             string     `json:"pushname,omitempty"`
+	Platform             string     `json:"platform,omitempty"`
+	LastConnectedAt      *time.Time `json:"last_connected_at,omitempty"`
+	LastDisconnectedAt   *time.Time `json:"last_disconnected_at,omitempty"`
+	LastDisconnectReason string     `json:"last_disconnect_reason,omitempty"`
+}
+
+type SessionManager struct {
+	mutex   sync.RWMutex
+	clients map[string]*whatsmeow.Client
+	states  map[string]*sessionState
+
+	locksMutex sync.Mutex
+	locks      map[string]*sessionLock
+}
+
+type sessionState struct {
+	lastConnectedAt      *time.Time
+	lastDisconnectedAt   *time.Time
+	lastDisconnectReason string
+}
+
+type sessionLock struct {
+	sync.Mutex
+	refs int
+}
+
+// NewSessionManager Create Empty WhatsApp Client Session Registry
+func NewSessionManager() *SessionManager {
+	return &SessionManager{
+		clients: make(map[string]*whatsmeow.Client),
+		states:  make(map[string]*sessionState),
+		locks:   make(map[string]*sessionLock),
+	}
+}
+
+// Create Register New WhatsApp Client for JID Using Given Constructor
+// Existing WhatsApp Client Will Be Returned When JID is Already Registered
+func (sm *SessionManager) Create(jid string, newClient func() *whatsmeow.Client) *whatsmeow.Client {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	if client, isExist := sm.clients[jid]; isExist {
+		return client
+	}
+
+	client := newClient()
+	if client != nil {
+		sm.clients[jid] = client
+	}
+
+	return client
+}
+
+// Get Get WhatsApp Client for JID, Return Nil When JID is Not Registered
+func (sm *SessionManager) Get(jid string) *whatsmeow.Client {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	return sm.clients[jid]
+}
+
+// List Get Every Registered JID Sorted Ascending
+func (sm *SessionManager) List() []string {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	jids := make([]string, 0, len(sm.clients))
+	for jid := range sm.clients {
+		jids = append(jids, jid)
+	}
+
+	sort.Strings(jids)
+
+	return jids
+}
+
+// Remove Unregister WhatsApp Client for JID
+// Only The Same WhatsApp Client Will Be Removed When Client is Given
+func (sm *SessionManager) Remove(jid string, client *whatsmeow.Client) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	if client == nil || sm.clients[jid] == client {
+		delete(sm.clients, jid)
+	}
+}
+
+// SetConnected Record WhatsApp Client Connected Time
+func (sm *SessionManager) SetConnected(jid string) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	now := time.Now().UTC()
+	sm.state(jid).lastConnectedAt = &now
+}
+
+// SetDisconnected Record WhatsApp Client Disconnected Time and Reason
+func (sm *SessionManager) SetDisconnected(jid string, reason string) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	now := time.Now().UTC()
+
+	state := sm.state(jid)
+	state.lastDisconnectedAt = &now
+	state.lastDisconnectReason = reason
+}
+
+func (sm *SessionManager) state(jid string) *sessionState {
+	state := sm.states[jid]
+	if state == nil {
+		state = &sessionState{}
+		sm.states[jid] = state
+	}
+
+	return state
+}
+
+// Status Get Connection State and Device Information for JID
+func (sm *SessionManager) Status(jid string) (*SessionStatus, error) {
+	sm.mutex.RLock()
+	client := sm.clients[jid]
+
+	var state sessionState
+	if sm.states[jid] != nil {
+		state = *sm.states[jid]
+	}
+	sm.mutex.RUnlock()
+
+	if client == nil {
+		return nil, errors.New("WhatsApp Client is not Valid")
+	}
+
+	status := &SessionStatus{
+		JID:                  jid,
+		IsConnected:          client.IsConnected(),
+		IsLoggedIn:           client.IsLoggedIn(),
+		PushName:             client.Store.PushName,
+		Platform:             client.Store.Platform,
+		LastConnectedAt:      state.lastConnectedAt,
+		LastDisconnectedAt:   state.lastDisconnectedAt,
+		LastDisconnectReason: state.lastDisconnectReason,
+	}
+
+	if client.Store.ID != nil {
+		status.DeviceJID = client.Store.ID.String()
+	}
+
+	return status, nil
+}
+
+// Lock Serialize Lifecycle Operation Such as Login, Logout and Reconnect for JID
+// The Returned Function Should Be Called to Release The Lock
+func (sm *SessionManager) Lock(jid string) func() {
+	sm.locksMutex.Lock()
+
+	lock := sm.locks[jid]
+	if lock == nil {
+		lock = &sessionLock{}
+		sm.locks[jid] = lock
+	}
+
+	lock.refs++
+	sm.locksMutex.Unlock()
+
+	lock.Lock()
+
+	return func() {
+		lock.Unlock()
+
+		// Free Lock When There is No More Operation Waiting
+		sm.locksMutex.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(sm.locks, jid)
+		}
+		sm.locksMutex.Unlock()
+	}
+}
@@ -0,0 +1,305 @@
+package whatsapp
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func sessionTestClient(user string) *whatsmeow.Client {
+	return &whatsmeow.Client{
+		Store: &store.Device{
+			ID: &types.JID{User: user, Server: types.DefaultUserServer},
+		},
+	}
+}
+
+func TestSessionManagerCreate(t *testing.T) {
+	sm := NewSessionManager()
+
+	client := sm.Create("6281", func() *whatsmeow.Client {
+		return sessionTestClient("6281")
+	})
+
+	// Existing WhatsApp Client Should Be Returned Without Calling Constructor
+	existing := sm.Create("6281", func() *whatsmeow.Client {
+		t.Error("constructor called for registered JID")
+		return nil
+	})
+
+	if existing != client {
+		t.Error("Create returned different client for registered JID")
+	}
+
+	// Nil WhatsApp Client Should Not Be Registered
+	if sm.Create("6282", func() *whatsmeow.Client { return nil }) != nil || sm.Get("6282") != nil {
+		t.Error("nil client is registered")
+	}
+
+	if jids := sm.List(); len(jids) != 1 || jids[0] != "6281" {
+		t.Errorf("List = %v, want [6281]", jids)
+	}
+}
+
+func TestSessionManagerRemove(t *testing.T) {
+	sm := NewSessionManager()
+
+	client := sm.Create("6281", func() *whatsmeow.Client {
+		return sessionTestClient("6281")
+	})
+
+	// Only The Same WhatsApp Client Should Be Removed
+	sm.Remove("6281", sessionTestClient("6281"))
+	if sm.Get("6281") != client {
+		t.Error("Remove with other client removed registered client")
+	}
+
+	sm.Remove("6281", client)
+	if sm.Get("6281") != nil {
+		t.Error("Remove with registered client did not remove it")
+	}
+
+	sm.Create("6281", func() *whatsmeow.Client {
+		return sessionTestClient("6281")
+	})
+
+	sm.Remove("6281", nil)
+	if sm.Get("6281") != nil {
+		t.Error("Remove without client did not remove it")
+	}
+}
+
+func TestSessionManagerConcurrent(t *testing.T) {
+	sm := NewSessionManager()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			jid := strconv.Itoa(6280 + i%8)
+
+			for n := 0; n < 200; n++ {
+				client := sm.Create(jid, func() *whatsmeow.Client {
+					return sessionTestClient(jid)
+				})
+
+				sm.SetConnected(jid)
+				_ = sm.Get(jid)
+				_ = sm.List()
+				sm.SetDisconnected(jid, "Test")
+
+				if n%3 == 0 {
+					sm.Remove(jid, client)
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	for _, jid := range sm.List() {
+		if sm.Get(jid) == nil {
+			t.Errorf("listed JID %s has no client", jid)
+		}
+	}
+}
+
+func TestSessionManagerLock(t *testing.T) {
+	sm := NewSessionManager()
+
+	// Counter is Not Atomic, Race Detector Will Report
+	// When Lock Does Not Serialize Operation for The Same JID
+	counters := make([]int, 4)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			jid := strconv.Itoa(6280 + i%4)
+
+			for n := 0; n < 100; n++ {
+				unlock := sm.Lock(jid)
+				counters[i%4]++
+				unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, counter := range counters {
+		if counter != 800 {
+			t.Errorf("counter for %d = %d, want 800", 6280+i, counter)
+		}
+	}
+
+	sm.locksMutex.Lock()
+	defer sm.locksMutex.Unlock()
+
+	if len(sm.locks) != 0 {
+		t.Errorf("%d lock(s) left after every operation is done", len(sm.locks))
+	}
+}
+
+func TestSessionManagerIterateWhileRemoving(t *testing.T) {
+	sm := NewSessionManager()
+
+	// Odd JID is Registered With Mismatch Device JID
+	for i := 0; i < 64; i++ {
+		jid := strconv.Itoa(6280000 + i)
+
+		user := jid
+		if i%2 == 1 {
+			user = "0" + jid
+		}
+
+		sm.Create(jid, func() *whatsmeow.Client {
+			return sessionTestClient(user)
+		})
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// Remove Some WhatsApp Client While Routine is Iterating
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 64; i += 4 {
+			sm.Remove(strconv.Itoa(6280000+i), nil)
+		}
+	}()
+
+	// Same Loop as Routine Checking Authenticated MSISDN
+	go func() {
+		defer wg.Done()
+
+		for _, jid := range sm.List() {
+			client := sm.Get(jid)
+			if client == nil || client.Store.ID == nil {
+				continue
+			}
+
+			if jid != client.Store.ID.User {
+				sm.Remove(jid, client)
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	for _, jid := range sm.List() {
+		client := sm.Get(jid)
+		if client == nil {
+			t.Errorf("listed JID %s has no client", jid)
+			continue
+		}
+
+		if client.Store.ID.User != jid {
+			t.Errorf("mismatch client for %s is not removed", jid)
+		}
+	}
+
+	for i := 0; i < 64; i += 4 {
+		if sm.Get(strconv.Itoa(6280000+i)) != nil {
+			t.Errorf("removed JID %d is still registered", 6280000+i)
+		}
+	}
+}
+
+func sessionTestWaiting(jid string, refs int) bool {
+	WhatsAppSession.locksMutex.Lock()
+	defer WhatsAppSession.locksMutex.Unlock()
+
+	lock := WhatsAppSession.locks[jid]
+	return lock != nil && lock.refs >= refs
+}
+
+func TestWhatsAppLogoutAfterClientReplaced(t *testing.T) {
+	jid := "session-test-replaced"
+
+	oldClient := WhatsAppSession.Create(jid, func() *whatsmeow.Client {
+		return &whatsmeow.Client{Store: &store.Device{}}
+	})
+
+	// Hold Lock Like Login That is Replacing WhatsApp Client
+	unlock := WhatsAppSession.Lock(jid)
+
+	done := make(chan error)
+	go func() {
+		done <- WhatsAppLogout(jid)
+	}()
+
+	for !sessionTestWaiting(jid, 2) {
+		time.Sleep(time.Millisecond)
+	}
+
+	WhatsAppSession.Remove(jid, oldClient)
+	newClient := WhatsAppSession.Create(jid, func() *whatsmeow.Client {
+		return &whatsmeow.Client{Store: &store.Device{}}
+	})
+
+	unlock()
+	<-done
+
+	// Logout Should Remove The Client Registered When It Got The Lock
+	if client := WhatsAppSession.Get(jid); client != nil {
+		if client == newClient {
+			t.Error("logout worked on replaced client and left new client registered")
+		} else {
+			t.Error("unexpected client registered after logout")
+		}
+	}
+}
+
+func TestWhatsAppLoginRacingLogout(t *testing.T) {
+	jid := "session-test-racing"
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(2)
+
+		// Login Register New WhatsApp Client Then Reconnect It
+		go func() {
+			defer wg.Done()
+
+			for n := 0; n < 50; n++ {
+				WhatsAppSession.Create(jid, func() *whatsmeow.Client {
+					return &whatsmeow.Client{Store: &store.Device{}}
+				})
+
+				_ = WhatsAppReconnect(jid)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for n := 0; n < 50; n++ {
+				_ = WhatsAppLogout(jid)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	_ = WhatsAppLogout(jid)
+	if WhatsAppSession.Get(jid) != nil {
+		t.Error("client is still registered after logout")
+	}
+
+	if sessionTestWaiting(jid, 1) {
+		t.Error("lock is left after every operation is done")
+	}
+}
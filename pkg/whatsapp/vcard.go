@@ -0,0 +1,272 @@
+package whatsapp
+
+import (
+	"errors"
+	"strings"
+)
+
+type VCardPhone struct {
+	Number string
+	Type   string
+}
+
+type VCard struct {
+	Name         string
+	Organization string
+	Emails       []string
+	Phones       []VCardPhone
+}
+
+var vcardEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	",", `\,`,
+	";", `\;`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+var vcardUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\,`, ",",
+	`\;`, ";",
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+// String Compose vCard 3.0 Content With Escaped Values
+// And WhatsApp ID Parameter for Every Phone Number
+func (vcard VCard) String() string {
+	var builder strings.Builder
+
+	name := vcardEscaper.Replace(vcard.Name)
+
+	builder.WriteString("BEGIN:VCARD\n")
+	builder.WriteString("VERSION:3.0\n")
+	builder.WriteString("N:;" + name + ";;;\n")
+	builder.WriteString("FN:" + name + "\n")
+
+	if len(vcard.Organization) > 0 {
+		builder.WriteString("ORG:" + vcardEscaper.Replace(vcard.Organization) + "\n")
+	}
+
+	for _, email := range vcard.Emails {
+		builder.WriteString("EMAIL;type=INTERNET:" + vcardEscaper.Replace(email) + "\n")
+	}
+
+	for _, phone := range vcard.Phones {
+		phoneType := strings.ToUpper(phone.Type)
+		if len(phoneType) == 0 {
+			phoneType = "CELL"
+		}
+
+		phoneDigits := VCardPhoneDigits(phone.Number)
+		if len(phoneDigits) == 0 {
+			continue
+		}
+
+		builder.WriteString("TEL;type=" + vcardEscaper.Replace(phoneType) + ";waid=" + phoneDigits + ":+" + phoneDigits + "\n")
+	}
+
+	builder.WriteString("END:VCARD")
+
+	return builder.String()
+}
+
+// VCardPhoneDigits Strip Every Non Digit Character from Phone Number
+func VCardPhoneDigits(number string) string {
+	var builder strings.Builder
+
+	for _, char := range number {
+		if char >= '0' && char <= '9' {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+// ParseVCard Parse One or More vCard 3.0 / 4.0 Content
+func ParseVCard(data string) ([]VCard, error) {
+	var vcards []VCard
+	var vcard *VCard
+
+	// Normalize Line Ending and Unfold Continuation Lines
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\n ", "")
+	data = strings.ReplaceAll(data, "\n\t", "")
+
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		// Split Property and Value Section
+		// The Value Section Start After The First Unquoted Colon
+		sepIndex := vcardValueIndex(line)
+		if sepIndex < 0 {
+			return nil, errors.New("Invalid vCard Line Format")
+		}
+
+		property, value := line[:sepIndex], line[sepIndex+1:]
+
+		// Split Property Name and Parameters
+		params := strings.Split(property, ";")
+		name := strings.ToUpper(params[0])
+
+		// Remove Property Group Prefix, e.g. "item1.TEL"
+		if dotIndex := strings.LastIndex(name, "."); dotIndex >= 0 {
+			name = name[dotIndex+1:]
+		}
+
+		switch name {
+		case "BEGIN":
+			if !strings.EqualFold(value, "VCARD") {
+				return nil, errors.New("Invalid vCard Begin Section")
+			}
+
+			vcard = &VCard{}
+			continue
+
+		case "END":
+			if vcard == nil || !strings.EqualFold(value, "VCARD") {
+				return nil, errors.New("Invalid vCard End Section")
+			}
+
+			if len(vcard.Name) == 0 {
+				return nil, errors.New("vCard Name is Empty")
+			}
+
+			vcards = append(vcards, *vcard)
+			vcard = nil
+			continue
+		}
+
+		if vcard == nil {
+			return nil, errors.New("vCard Property Found Outside Begin and End Section")
+		}
+
+		switch name {
+		case "VERSION":
+			if value != "3.0" && value != "4.0" {
+				return nil, errors.New("vCard Version " + value + " is Not Supported")
+			}
+
+		case "FN":
+			vcard.Name = vcardUnescaper.Replace(value)
+
+		case "N":
+			// Use Structured Name Only When Formatted Name is Not Exist
+			if len(vcard.Name) == 0 {
+				var names []string
+
+				// Structured Name Order is Family;Given;Additional;Prefix;Suffix
+				// Compose it as Prefix Given Additional Family Suffix
+				components := vcardSplit(value)
+				for _, index := range []int{3, 1, 2, 0, 4} {
+					if index < len(components) && len(components[index]) > 0 {
+						names = append(names, components[index])
+					}
+				}
+
+				vcard.Name = strings.Join(names, " ")
+			}
+
+		case "ORG":
+			var orgs []string
+
+			for _, org := range vcardSplit(value) {
+				if len(org) > 0 {
+					orgs = append(orgs, org)
+				}
+			}
+
+			vcard.Organization = strings.Join(orgs, ", ")
+
+		case "EMAIL":
+			email := vcardUnescaper.Replace(value)
+			if len(email) > 0 {
+				vcard.Emails = append(vcard.Emails, email)
+			}
+
+		case "TEL":
+			// vCard 4.0 Phone Number Can be Written as URI
+			number := strings.TrimPrefix(vcardUnescaper.Replace(value), "tel:")
+			if len(VCardPhoneDigits(number)) == 0 {
+				continue
+			}
+
+			var phoneType string
+			for _, param := range params[1:] {
+				paramKey, paramValue, _ := strings.Cut(param, "=")
+				if strings.EqualFold(paramKey, "TYPE") {
+					for _, typeValue := range strings.Split(strings.Trim(paramValue, `"`), ",") {
+						if !strings.EqualFold(typeValue, "VOICE") && !strings.EqualFold(typeValue, "PREF") {
+							phoneType = strings.ToUpper(typeValue)
+							break
+						}
+					}
+				} else if len(paramValue) == 0 && !strings.EqualFold(paramKey, "PREF") {
+					// vCard 2.1 Style Parameter, e.g. "TEL;CELL"
+					phoneType = strings.ToUpper(paramKey)
+				}
+			}
+
+			vcard.Phones = append(vcard.Phones, VCardPhone{
+				Number: number,
+				Type:   phoneType,
+			})
+		}
+	}
+
+	if vcard != nil {
+		return nil, errors.New("vCard End Section is Not Exist")
+	}
+
+	if len(vcards) == 0 {
+		return nil, errors.New("vCard Content is Empty")
+	}
+
+	return vcards, nil
+}
+
+func vcardValueIndex(line string) int {
+	isQuoted := false
+
+	for index, char := range line {
+		switch char {
+		case '"':
+			isQuoted = !isQuoted
+		case ':':
+			if !isQuoted {
+				return index
+			}
+		}
+	}
+
+	return -1
+}
+
+func vcardSplit(value string) []string {
+	var components []string
+	var builder strings.Builder
+
+	isEscaped := false
+	for _, char := range value {
+		switch {
+		case isEscaped:
+			builder.WriteRune('\\')
+			builder.WriteRune(char)
+			isEscaped = false
+		case char == '\\':
+			isEscaped = true
+		case char == ';':
+			components = append(components, vcardUnescaper.Replace(builder.String()))
+			builder.Reset()
+		default:
+			builder.WriteRune(char)
+		}
+	}
+
+	return append(components, vcardUnescaper.Replace(builder.String()))
+}
@@ -1,14 +1,20 @@
 package whatsapp
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"image"
+	"image/draw"
 	"runtime"
+	"sort"
 	"strings"
 
+	webp "github.com/nickalie/go-webpbin"
 	qrCode "github.com/skip2/go-qrcode"
+	"github.com/sunshineplan/imgconv"
 	"google.golang.org/protobuf/proto"
 
 	"go.mau.fi/whatsmeow"
@@ -18,45 +24,46 @@ import (
 	"go.mau.fi/whatsmeow/store/sqlstore"
 	"go.mau.fi/whatsmeow/types"
 
+	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/datastore"
 	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/env"
-	"github.com/dimaskiddo/go-whatsapp-multidevice-rest/pkg/log"
 )
 
 var WhatsAppDatastore *sqlstore.Container
-var WhatsAppClient = make(map[string]*whatsmeow.Client)
+var WhatsAppSession = NewSessionManager()
 
 var (
 	WhatsAppClientProxyURL string
+
+	WhatsAppMediaImageCompression bool
+	WhatsAppMediaImageConvertWebP bool
 )
 
 func init() {
-	var err error
+	WhatsAppClientProxyURL, _ = env.GetEnvString("WHATSAPP_CLIENT_PROXY_URL")
 
-	dbType, err := env.GetEnvString("WHATSAPP_DATASTORE_TYPE")
-	if err != nil {
-		log.Print(nil).Fatal("Error Parse Environment Variable for WhatsApp Client Datastore Type")
-	}
+	WhatsAppMediaImageCompression, _ = env.GetEnvBool("WHATSAPP_MEDIA_IMAGE_COMPRESSION")
+	WhatsAppMediaImageConvertWebP, _ = env.GetEnvBool("WHATSAPP_MEDIA_IMAGE_CONVERT_WEBP")
+}
 
-	dbURI, err := env.GetEnvString("WHATSAPP_DATASTORE_URI")
-	if err != nil {
-		log.Print(nil).Fatal("Error Parse Environment Variable for WhatsApp Client Datastore URI")
-	}
+// WhatsAppDatastoreConnect Use Shared Datastore Connection as WhatsApp Client Datastore
+// Should be Called After Shared Datastore is Connected
+func WhatsAppDatastoreConnect() error {
+	WhatsAppDatastore = sqlstore.NewWithDB(datastore.DB, datastore.DBType, nil)
 
-	datastore, err := sqlstore.New(dbType, dbURI, nil)
+	err := WhatsAppDatastore.Upgrade()
 	if err != nil {
-		log.Print(nil).Fatal("Error Connect WhatsApp Client Datastore")
+		return errors.New("Error Connect WhatsApp Client Datastore")
 	}
 
-	WhatsAppClientProxyURL, _ = env.GetEnvString("WHATSAPP_CLIENT_PROXY_URL")
-
-	WhatsAppDatastore = datastore
+	return nil
 }
 
 func WhatsAppInitClient(device *store.Device, jid string) {
-	var err error
 	wabin.IndentXML = true
 
-	if WhatsAppClient[jid] == nil {
+	WhatsAppSession.Create(jid, func() *whatsmeow.Client {
+		var err error
+
 		if device == nil {
 			// Initialize New WhatsApp Client Device in Datastore
 			device = WhatsAppDatastore.NewDevice()
@@ -82,23 +89,30 @@ func WhatsAppInitClient(device *store.Device, jid string) {
 		}
 
 		// Initialize New WhatsApp Client
-		// And Save it to The Map
-		WhatsAppClient[jid] = whatsmeow.NewClient(device, nil)
+		// It Will be Saved to The Session Registry
+		client := whatsmeow.NewClient(device, nil)
 
 		// Set WhatsApp Client Proxy Address if Proxy URL is Provided
 		if len(WhatsAppClientProxyURL) > 0 {
-			WhatsAppClient[jid].SetProxyAddress(WhatsAppClientProxyURL)
+			client.SetProxyAddress(WhatsAppClientProxyURL)
 		}
 
 		// Set WhatsApp Client Auto Reconnect
-		WhatsAppClient[jid].EnableAutoReconnect = true
+		client.EnableAutoReconnect = true
 
 		// Set WhatsApp Client Auto Trust Identity
-		WhatsAppClient[jid].AutoTrustIdentity = true
+		client.AutoTrustIdentity = true
 
 		// Disable Self Broadcast
-		WhatsAppClient[jid].DontSendSelfBroadcast = true
-	}
+		client.DontSendSelfBroadcast = true
+
+		// Register WhatsApp Client Event Handler
+		client.AddEventHandler(func(evt interface{}) {
+			WhatsAppEventHandler(jid, evt)
+		})
+
+		return client
+	})
 }
 
 func WhatsAppGetUserAgent(agentType string) waproto.DeviceProps_PlatformType {
@@ -156,52 +170,104 @@ func WhatsAppGetUserOS() string {
 }
 
 func WhatsAppGenerateQR(qrChan <-chan whatsmeow.QRChannelItem) (string, int) {
-	qrChanCode := make(chan string)
-	qrChanTimeout := make(chan int)
-
-	// Get QR Code Data and Timeout
-	go func() {
-		for evt := range qrChan {
-			if evt.Event == "code" {
-				qrChanCode <- evt.Code
-				qrChanTimeout <- int(evt.Timeout.Seconds())
+	// Wait for The First QR Code Data and Timeout
+	for evt := range qrChan {
+		if evt.Event == whatsmeow.QRChannelEventCode {
+			// Keep Draining The Rest of QR Channel Items
+			// Until QR Channel is Closed by Pairing Result
+			go func() {
+				for range qrChan {
+				}
+			}()
+
+			// Generate QR Code Data to PNG Image
+			qrPNG, _ := qrCode.Encode(evt.Code, qrCode.Medium, 256)
+
+			// Return QR Code PNG in Base64 Format and Timeout Information
+			return base64.StdEncoding.EncodeToString(qrPNG), int(evt.Timeout.Seconds())
+		}
+	}
+
+	// QR Channel is Closed Before Any QR Code Generated
+	return "", 0
+}
+
+// WhatsAppRenderQR Render QR Code Data as PNG Base64 Data URI, SVG Image or Terminal ASCII
+func WhatsAppRenderQR(code string, output string) (string, error) {
+	qr, err := qrCode.New(code, qrCode.Medium)
+	if err != nil {
+		return "", err
+	}
+
+	switch strings.ToLower(output) {
+	case "", "png":
+		qrPNG, err := qr.PNG(256)
+		if err != nil {
+			return "", err
+		}
+
+		return "data:image/png;base64," + base64.StdEncoding.EncodeToString(qrPNG), nil
+
+	case "svg":
+		bitmap := qr.Bitmap()
+
+		var svg strings.Builder
+		svg.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, len(bitmap), len(bitmap)))
+		svg.WriteString(`<rect width="100%" height="100%" fill="#ffffff"/><path fill="#000000" d="`)
+
+		for y, row := range bitmap {
+			for x, isBlack := range row {
+				if isBlack {
+					svg.WriteString(fmt.Sprintf("M%d %dh1v1h-1z", x, y))
+				}
 			}
 		}
-	}()
 
-	// Generate QR Code Data to PNG Image
-	qrTemp := <-qrChanCode
-	qrPNG, _ := qrCode.Encode(qrTemp, qrCode.Medium, 256)
+		svg.WriteString(`"/></svg>`)
+
+		return svg.String(), nil
+
+	case "ascii":
+		return qr.ToSmallString(false), nil
 
-	// Return QR Code PNG in Base64 Format and Timeout Information
-	return base64.StdEncoding.EncodeToString(qrPNG), <-qrChanTimeout
+	default:
+		return "", errors.New("QR Code Output Should be PNG, SVG or ASCII")
+	}
 }
 
 func WhatsAppLogin(jid string) (string, int, error) {
-	if WhatsAppClient[jid] != nil {
+	// Serialize Login, Logout and Reconnect for The Same JID
+	// Client is Taken After Locking So It is Not Replaced or Removed in The Middle
+	defer WhatsAppSession.Lock(jid)()
+
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		// Make Sure WebSocket Connection is Disconnected
-		WhatsAppClient[jid].Disconnect()
+		client.Disconnect()
 
-		if WhatsAppClient[jid].Store.ID == nil {
+		if client.Store.ID == nil {
 			// Device ID is not Exist
 			// Generate QR Code
-			qrChanGenerate, _ := WhatsAppClient[jid].GetQRChannel(context.Background())
+			qrChanGenerate, _ := client.GetQRChannel(context.Background())
 
 			// Connect WebSocket while Initialize QR Code Data to be Sent
-			err := WhatsAppClient[jid].Connect()
+			err := client.Connect()
 			if err != nil {
 				return "", 0, err
 			}
 
 			// Get Generated QR Code and Timeout Information
 			qrImage, qrTimeout := WhatsAppGenerateQR(qrChanGenerate)
+			if len(qrImage) == 0 {
+				return "", 0, errors.New("WhatsApp QR Code is Not Generated")
+			}
 
 			// Return QR Code in Base64 Format and Timeout Information
 			return "data:image/png;base64," + qrImage, qrTimeout, nil
 		} else {
 			// Device ID is Exist
 			// Reconnect WebSocket
-			err := WhatsAppReconnect(jid)
+			err := whatsAppReconnect(client)
 			if err != nil {
 				return "", 0, err
 			}
@@ -214,20 +280,64 @@ func WhatsAppLogin(jid string) (string, int, error) {
 	return "", 0, errors.New("WhatsApp Client is not Valid")
 }
 
+// WhatsAppLoginStream Start QR Code Login and Return Every QR Channel Item
+// QR Channel Will Be Closed When Context is Done or Pairing is Finished
+// Nil QR Channel Means WhatsApp Client is Already Paired and Reconnected
+func WhatsAppLoginStream(ctx context.Context, jid string) (<-chan whatsmeow.QRChannelItem, error) {
+	// Serialize Login, Logout and Reconnect for The Same JID
+	// Client is Taken After Locking So It is Not Replaced or Removed in The Middle
+	defer WhatsAppSession.Lock(jid)()
+
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		// Make Sure WebSocket Connection is Disconnected
+		client.Disconnect()
+
+		if client.Store.ID == nil {
+			// Device ID is not Exist
+			// Generate QR Code Channel Bound to Context
+			qrChanGenerate, err := client.GetQRChannel(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			// Connect WebSocket while Initialize QR Code Data to be Sent
+			err = client.Connect()
+			if err != nil {
+				return nil, err
+			}
+
+			return qrChanGenerate, nil
+		}
+
+		// Device ID is Exist
+		// Reconnect WebSocket
+		return nil, whatsAppReconnect(client)
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
 func WhatsAppLoginPair(jid string) (string, int, error) {
-	if WhatsAppClient[jid] != nil {
+	// Serialize Login, Logout and Reconnect for The Same JID
+	// Client is Taken After Locking So It is Not Replaced or Removed in The Middle
+	defer WhatsAppSession.Lock(jid)()
+
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		// Make Sure WebSocket Connection is Disconnected
-		WhatsAppClient[jid].Disconnect()
+		client.Disconnect()
 
-		if WhatsAppClient[jid].Store.ID == nil {
+		if client.Store.ID == nil {
 			// Connect WebSocket while also Requesting Pairing Code
-			err := WhatsAppClient[jid].Connect()
+			err := client.Connect()
 			if err != nil {
 				return "", 0, err
 			}
 
 			// Request Pairing Code
-			code, err := WhatsAppClient[jid].PairPhone(jid, true, whatsmeow.PairClientChrome, "Chrome ("+WhatsAppGetUserOS()+")")
+			code, err := client.PairPhone(jid, true, whatsmeow.PairClientChrome, "Chrome ("+WhatsAppGetUserOS()+")")
 			if err != nil {
 				return "", 0, err
 			}
@@ -236,7 +346,7 @@ func WhatsAppLoginPair(jid string) (string, int, error) {
 		} else {
 			// Device ID is Exist
 			// Reconnect WebSocket
-			err := WhatsAppReconnect(jid)
+			err := whatsAppReconnect(client)
 			if err != nil {
 				return "", 0, err
 			}
@@ -250,56 +360,76 @@ func WhatsAppLoginPair(jid string) (string, int, error) {
 }
 
 func WhatsAppReconnect(jid string) error {
-	if WhatsAppClient[jid] != nil {
-		// Make Sure WebSocket Connection is Disconnected
-		WhatsAppClient[jid].Disconnect()
+	// Serialize Login, Logout and Reconnect for The Same JID
+	// Client is Taken After Locking So It is Not Replaced or Removed in The Middle
+	defer WhatsAppSession.Lock(jid)()
 
-		// Make Sure Store ID is not Empty
-		// To do Reconnection
-		if WhatsAppClient[jid] != nil {
-			err := WhatsAppClient[jid].Connect()
-			if err != nil {
-				return err
-			}
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		return whatsAppReconnect(client)
+	}
 
-			return nil
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+func whatsAppReconnect(client *whatsmeow.Client) error {
+	// Make Sure WebSocket Connection is Disconnected
+	client.Disconnect()
+
+	// Make Sure Store ID is not Empty
+	// To do Reconnection
+	if client.Store.ID != nil {
+		err := client.Connect()
+		if err != nil {
+			return err
 		}
 
-		return errors.New("WhatsApp Client Store ID is Empty, Please Re-Login and Scan QR Code Again")
+		return nil
 	}
 
-	return errors.New("WhatsApp Client is not Valid")
+	return errors.New("WhatsApp Client Store ID is Empty, Please Re-Login and Scan QR Code Again")
 }
 
 func WhatsAppLogout(jid string) error {
-	if WhatsAppClient[jid] != nil {
+	// Serialize Login, Logout and Reconnect for The Same JID
+	// Client is Taken After Locking So It is Not Replaced or Removed in The Middle
+	defer WhatsAppSession.Lock(jid)()
+
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		// Stop Outbound Queue Worker
+		WhatsAppQueueStop(jid)
+
 		// Make Sure Store ID is not Empty
-		if WhatsAppClient[jid] != nil {
+		if client.Store.ID != nil {
 			var err error
 
 			// Set WhatsApp Client Presence to Unavailable
 			WhatsAppPresence(jid, false)
 
 			// Logout WhatsApp Client and Disconnect from WebSocket
-			err = WhatsAppClient[jid].Logout()
+			err = client.Logout()
 			if err != nil {
 				// Force Disconnect
-				WhatsAppClient[jid].Disconnect()
+				client.Disconnect()
 
 				// Manually Delete Device from Datastore Store
-				err = WhatsAppClient[jid].Store.Delete()
+				err = client.Store.Delete()
 				if err != nil {
 					return err
 				}
 			}
 
-			// Free WhatsApp Client Map
-			WhatsAppClient[jid] = nil
-			delete(WhatsAppClient, jid)
+			// Remove WhatsApp Client from Session Registry
+			WhatsAppSession.Remove(jid, client)
 
 			return nil
 		}
 
+		// Remove Unpaired WhatsApp Client from Session Registry
+		client.Disconnect()
+		WhatsAppSession.Remove(jid, client)
+
 		return errors.New("WhatsApp Client Store ID is Empty, Please Re-Login and Scan QR Code Again")
 	}
 
@@ -308,13 +438,18 @@ func WhatsAppLogout(jid string) error {
 }
 
 func WhatsAppIsClientOK(jid string) error {
+	client := WhatsAppSession.Get(jid)
+	if client == nil {
+		return errors.New("WhatsApp Client is not Valid")
+	}
+
 	// Make Sure WhatsApp Client is Connected
-	if !WhatsAppClient[jid].IsConnected() {
+	if !client.IsConnected() {
 		return errors.New("WhatsApp Client is not Connected")
 	}
 
 	// Make Sure WhatsApp Client is Logged In
-	if !WhatsAppClient[jid].IsLoggedIn() {
+	if !client.IsLoggedIn() {
 		return errors.New("WhatsApp Client is not Logged In")
 	}
 
@@ -322,11 +457,12 @@ func WhatsAppIsClientOK(jid string) error {
 }
 
 func WhatsAppGetJID(jid string, id string) types.JID {
-	if WhatsAppClient[jid] != nil {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		var ids []string
 
 		ids = append(ids, "+"+id)
-		infos, err := WhatsAppClient[jid].IsOnWhatsApp(ids)
+		infos, err := client.IsOnWhatsApp(ids)
 		if err == nil {
 			// If WhatsApp ID is Registered Then
 			// Return ID Information
@@ -341,7 +477,8 @@ func WhatsAppGetJID(jid string, id string) types.JID {
 }
 
 func WhatsAppCheckJID(jid string, id string) (types.JID, error) {
-	if WhatsAppClient[jid] != nil {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		// Compose New Remote JID
 		remoteJID := WhatsAppComposeJID(id)
 		if remoteJID.Server != types.GroupServer {
@@ -384,7 +521,7 @@ func WhatsAppDecomposeJID(id string) string {
 	}
 
 	// Check if WhatsApp ID First Character is '+' Symbol
-	if id[0] == '+' {
+	if len(id) > 0 && id[0] == '+' {
 		// Remove '+' Symbol from WhatsApp ID
 		id = id[1:]
 	}
@@ -393,14 +530,24 @@ func WhatsAppDecomposeJID(id string) string {
 }
 
 func WhatsAppPresence(jid string, isAvailable bool) {
+	client := WhatsAppSession.Get(jid)
+	if client == nil {
+		return
+	}
+
 	if isAvailable {
-		_ = WhatsAppClient[jid].SendPresence(types.PresenceAvailable)
+		_ = client.SendPresence(types.PresenceAvailable)
 	} else {
-		_ = WhatsAppClient[jid].SendPresence(types.PresenceUnavailable)
+		_ = client.SendPresence(types.PresenceUnavailable)
 	}
 }
 
 func WhatsAppComposeStatus(jid string, rjid types.JID, isComposing bool, isAudio bool) {
+	client := WhatsAppSession.Get(jid)
+	if client == nil {
+		return
+	}
+
 	// Set Compose Status
 	var typeCompose types.ChatPresence
 	if isComposing {
@@ -418,11 +565,12 @@ func WhatsAppComposeStatus(jid string, rjid types.JID, isComposing bool, isAudio
 	}
 
 	// Send Chat Compose Status
-	_ = WhatsAppClient[jid].SendChatPresence(rjid, typeCompose, typeComposeMedia)
+	_ = client.SendChatPresence(rjid, typeCompose, typeComposeMedia)
 }
 
 func WhatsAppCheckRegistered(jid string, id string) error {
-	if WhatsAppClient[jid] != nil {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		var err error
 
 		// Make Sure WhatsApp Client is OK
@@ -450,7 +598,8 @@ func WhatsAppCheckRegistered(jid string, id string) error {
 }
 
 func WhatsAppSendText(ctx context.Context, jid string, rjid string, message string) (string, error) {
-	if WhatsAppClient[jid] != nil {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		var err error
 
 		// Make Sure WhatsApp Client is OK
@@ -475,14 +624,18 @@ func WhatsAppSendText(ctx context.Context, jid string, rjid string, message stri
 
 		// Compose WhatsApp Proto
 		msgExtra := whatsmeow.SendRequestExtra{
-			ID: WhatsAppClient[jid].GenerateMessageID(),
+			ID: client.GenerateMessageID(),
 		}
 		msgContent := &waproto.Message{
 			Conversation: proto.String(message),
 		}
 
 		// Send WhatsApp Message Proto
-		_, err = WhatsAppClient[jid].SendMessage(ctx, remoteJID, msgContent, msgExtra)
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
 		if err != nil {
 			return "", err
 		}
@@ -495,7 +648,8 @@ func WhatsAppSendText(ctx context.Context, jid string, rjid string, message stri
 }
 
 func WhatsAppSendLocation(ctx context.Context, jid string, rjid string, latitude float64, longitude float64) (string, error) {
-	if WhatsAppClient[jid] != nil {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		var err error
 
 		// Make Sure WhatsApp Client is OK
@@ -520,7 +674,7 @@ func WhatsAppSendLocation(ctx context.Context, jid string, rjid string, latitude
 
 		// Compose WhatsApp Proto
 		msgExtra := whatsmeow.SendRequestExtra{
-			ID: WhatsAppClient[jid].GenerateMessageID(),
+			ID: client.GenerateMessageID(),
 		}
 		msgContent := &waproto.Message{
 			LocationMessage: &waproto.LocationMessage{
@@ -530,7 +684,11 @@ func WhatsAppSendLocation(ctx context.Context, jid string, rjid string, latitude
 		}
 
 		// Send WhatsApp Message Proto
-		_, err = WhatsAppClient[jid].SendMessage(ctx, remoteJID, msgContent, msgExtra)
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
 		if err != nil {
 			return "", err
 		}
@@ -542,8 +700,9 @@ func WhatsAppSendLocation(ctx context.Context, jid string, rjid string, latitude
 	return "", errors.New("WhatsApp Client is not Valid")
 }
 
-func WhatsAppSendContact(ctx context.Context, jid string, rjid string, contactName string, contactNumber string) (string, error) {
-	if WhatsAppClient[jid] != nil {
+func WhatsAppSendLink(ctx context.Context, jid string, rjid string, linkCaption string, linkURL string) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		var err error
 
 		// Make Sure WhatsApp Client is OK
@@ -558,6 +717,12 @@ func WhatsAppSendContact(ctx context.Context, jid string, rjid string, contactNa
 			return "", err
 		}
 
+		// Fetch Link Preview Information
+		linkPreview, err := WhatsAppLinkPreviewFetch(ctx, linkURL)
+		if err != nil {
+			return "", err
+		}
+
 		// Set Chat Presence
 		WhatsAppPresence(jid, true)
 		WhatsAppComposeStatus(jid, remoteJID, true, false)
@@ -566,21 +731,35 @@ func WhatsAppSendContact(ctx context.Context, jid string, rjid string, contactNa
 			WhatsAppPresence(jid, false)
 		}()
 
+		// Message Text Should Contain The Link URL
+		// So WhatsApp Client Can Render The Link Preview
+		msgText := linkURL
+		if len(linkCaption) > 0 {
+			msgText = linkCaption + "\n" + linkURL
+		}
+
 		// Compose WhatsApp Proto
 		msgExtra := whatsmeow.SendRequestExtra{
-			ID: WhatsAppClient[jid].GenerateMessageID(),
+			ID: client.GenerateMessageID(),
 		}
-		msgVCard := fmt.Sprintf("BEGIN:VCARD\nVERSION:3.0\nN:;%v;;;\nFN:%v\nTEL;type=CELL;waid=%v:+%v\nEND:VCARD",
-			contactName, contactName, contactNumber, contactNumber)
 		msgContent := &waproto.Message{
-			ContactMessage: &waproto.ContactMessage{
-				DisplayName: proto.String(contactName),
-				Vcard:       proto.String(msgVCard),
+			ExtendedTextMessage: &waproto.ExtendedTextMessage{
+				Text:          proto.String(msgText),
+				MatchedText:   proto.String(linkURL),
+				CanonicalURL:  proto.String(linkPreview.URL),
+				Title:         proto.String(linkPreview.Title),
+				Description:   proto.String(linkPreview.Description),
+				PreviewType:   waproto.ExtendedTextMessage_NONE.Enum(),
+				JPEGThumbnail: linkPreview.Thumbnail,
 			},
 		}
 
 		// Send WhatsApp Message Proto
-		_, err = WhatsAppClient[jid].SendMessage(ctx, remoteJID, msgContent, msgExtra)
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
 		if err != nil {
 			return "", err
 		}
@@ -592,30 +771,810 @@ func WhatsAppSendContact(ctx context.Context, jid string, rjid string, contactNa
 	return "", errors.New("WhatsApp Client is not Valid")
 }
 
-func WhatsAppGroupGet(jid string) ([]types.GroupInfo, error) {
-	if WhatsAppClient[jid] != nil {
+func WhatsAppSendPoll(ctx context.Context, jid string, rjid string, question string, options []string, isMultiAnswer bool) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		var err error
 
+		// Make Sure Poll Has Between 2 and 12 Options
+		if len(options) < 2 || len(options) > 12 {
+			return "", errors.New("WhatsApp Poll Should Have 2 Until 12 Options")
+		}
+
 		// Make Sure WhatsApp Client is OK
 		err = WhatsAppIsClientOK(jid)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
-		// Get Joined Group List
-		groups, err := WhatsAppClient[jid].GetJoinedGroups()
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
-		// Put Group Information in List
-		var gids []types.GroupInfo
-		for _, group := range groups {
-			gids = append(gids, *group)
+		// Set Chat Presence
+		WhatsAppPresence(jid, true)
+		WhatsAppComposeStatus(jid, remoteJID, true, false)
+		defer func() {
+			WhatsAppComposeStatus(jid, remoteJID, false, false)
+			WhatsAppPresence(jid, false)
+		}()
+
+		// Set Selectable Options Count
+		// Zero Means Any Number of Options Can Be Selected
+		selectableOptions := 1
+		if isMultiAnswer {
+			selectableOptions = 0
 		}
 
-		// Return Group Information List
-		return gids, nil
+		// Compose WhatsApp Proto
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := client.BuildPollCreation(question, options, selectableOptions)
+
+		// Send WhatsApp Message Proto
+		msgResponse, err := client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		// Register Poll for Vote Tally
+		WhatsAppPollRegister(jid, remoteJID, msgExtra.ID, msgContent.GetPollCreationMessage(), msgResponse.Timestamp)
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppSendDocument(ctx context.Context, jid string, rjid string, documentBytes []byte, documentType string, documentName string) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return "", err
+		}
+
+		// Set Chat Presence
+		WhatsAppPresence(jid, true)
+		WhatsAppComposeStatus(jid, remoteJID, true, false)
+		defer func() {
+			WhatsAppComposeStatus(jid, remoteJID, false, false)
+			WhatsAppPresence(jid, false)
+		}()
+
+		// Upload Document to WhatsApp Storage Server
+		documentUploaded, err := client.Upload(ctx, documentBytes, whatsmeow.MediaDocument)
+		if err != nil {
+			return "", errors.New("Error While Uploading Media to WhatsApp Server")
+		}
+
+		// Compose WhatsApp Proto
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := &waproto.Message{
+			DocumentMessage: &waproto.DocumentMessage{
+				URL:           proto.String(documentUploaded.URL),
+				DirectPath:    proto.String(documentUploaded.DirectPath),
+				Mimetype:      proto.String(documentType),
+				Title:         proto.String(documentName),
+				FileName:      proto.String(documentName),
+				FileLength:    proto.Uint64(documentUploaded.FileLength),
+				FileSHA256:    documentUploaded.FileSHA256,
+				FileEncSHA256: documentUploaded.FileEncSHA256,
+				MediaKey:      documentUploaded.MediaKey,
+			},
+		}
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppSendImage(ctx context.Context, jid string, rjid string, imageBytes []byte, imageType string, imageCaption string, isViewOnce bool) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return "", err
+		}
+
+		// Set Chat Presence
+		WhatsAppPresence(jid, true)
+		WhatsAppComposeStatus(jid, remoteJID, true, false)
+		defer func() {
+			WhatsAppComposeStatus(jid, remoteJID, false, false)
+			WhatsAppPresence(jid, false)
+		}()
+
+		// Old Version of WhatsApp Client Cannot Render WebP Format
+		// If MIME Type is "image/webp" Then Convert it as PNG
+		if imageType == "image/webp" && WhatsAppMediaImageConvertWebP {
+			imgConvDecode, err := imgconv.Decode(bytes.NewReader(imageBytes))
+			if err != nil {
+				return "", errors.New("Error While Decoding Convert Image Stream")
+			}
+
+			imgConvEncode := new(bytes.Buffer)
+
+			err = imgconv.Write(imgConvEncode, imgConvDecode, &imgconv.FormatOption{Format: imgconv.PNG})
+			if err != nil {
+				return "", errors.New("Error While Encoding Convert Image Stream")
+			}
+
+			imageBytes = imgConvEncode.Bytes()
+			imageType = "image/png"
+		}
+
+		// If WhatsApp Media Compression Enabled
+		// Then Resize The Image to Maximum Width 1024px and Preserve Aspect Ratio
+		if WhatsAppMediaImageCompression {
+			imgResizeDecode, err := imgconv.Decode(bytes.NewReader(imageBytes))
+			if err != nil {
+				return "", errors.New("Error While Decoding Resize Image Stream")
+			}
+
+			if imgResizeDecode.Bounds().Dx() > 1024 {
+				imgResizeEncode := new(bytes.Buffer)
+
+				err = imgconv.Write(imgResizeEncode,
+					imgconv.Resize(imgResizeDecode, &imgconv.ResizeOption{Width: 1024}),
+					&imgconv.FormatOption{Format: imgconv.JPEG})
+				if err != nil {
+					return "", errors.New("Error While Encoding Resize Image Stream")
+				}
+
+				imageBytes = imgResizeEncode.Bytes()
+				imageType = "image/jpeg"
+			}
+		}
+
+		// Creating Image JPEG Thumbnail
+		// With Permanent Width 72px and Preserve Aspect Ratio
+		imgThumbDecode, err := imgconv.Decode(bytes.NewReader(imageBytes))
+		if err != nil {
+			return "", errors.New("Error While Decoding Thumbnail Image Stream")
+		}
+
+		imgThumbEncode := new(bytes.Buffer)
+
+		err = imgconv.Write(imgThumbEncode,
+			imgconv.Resize(imgThumbDecode, &imgconv.ResizeOption{Width: 72}),
+			&imgconv.FormatOption{Format: imgconv.JPEG})
+		if err != nil {
+			return "", errors.New("Error While Encoding Thumbnail Image Stream")
+		}
+
+		// Upload Image to WhatsApp Storage Server
+		imageUploaded, err := client.Upload(ctx, imageBytes, whatsmeow.MediaImage)
+		if err != nil {
+			return "", errors.New("Error While Uploading Media to WhatsApp Server")
+		}
+
+		// Compose WhatsApp Proto
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := &waproto.Message{
+			ImageMessage: &waproto.ImageMessage{
+				URL:           proto.String(imageUploaded.URL),
+				DirectPath:    proto.String(imageUploaded.DirectPath),
+				Mimetype:      proto.String(imageType),
+				Caption:       proto.String(imageCaption),
+				FileLength:    proto.Uint64(imageUploaded.FileLength),
+				FileSHA256:    imageUploaded.FileSHA256,
+				FileEncSHA256: imageUploaded.FileEncSHA256,
+				MediaKey:      imageUploaded.MediaKey,
+				JPEGThumbnail: imgThumbEncode.Bytes(),
+				ViewOnce:      proto.Bool(isViewOnce),
+			},
+		}
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppSendAudio(ctx context.Context, jid string, rjid string, audioBytes []byte, audioType string) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return "", err
+		}
+
+		// Set Chat Presence
+		WhatsAppPresence(jid, true)
+		WhatsAppComposeStatus(jid, remoteJID, true, true)
+		defer func() {
+			WhatsAppComposeStatus(jid, remoteJID, false, true)
+			WhatsAppPresence(jid, false)
+		}()
+
+		// Upload Audio to WhatsApp Storage Server
+		audioUploaded, err := client.Upload(ctx, audioBytes, whatsmeow.MediaAudio)
+		if err != nil {
+			return "", errors.New("Error While Uploading Media to WhatsApp Server")
+		}
+
+		// Compose WhatsApp Proto
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := &waproto.Message{
+			AudioMessage: &waproto.AudioMessage{
+				URL:           proto.String(audioUploaded.URL),
+				DirectPath:    proto.String(audioUploaded.DirectPath),
+				Mimetype:      proto.String(audioType),
+				FileLength:    proto.Uint64(audioUploaded.FileLength),
+				FileSHA256:    audioUploaded.FileSHA256,
+				FileEncSHA256: audioUploaded.FileEncSHA256,
+				MediaKey:      audioUploaded.MediaKey,
+			},
+		}
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppSendVideo(ctx context.Context, jid string, rjid string, videoBytes []byte, videoType string, videoCaption string, isViewOnce bool) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return "", err
+		}
+
+		// Set Chat Presence
+		WhatsAppPresence(jid, true)
+		WhatsAppComposeStatus(jid, remoteJID, true, false)
+		defer func() {
+			WhatsAppComposeStatus(jid, remoteJID, false, false)
+			WhatsAppPresence(jid, false)
+		}()
+
+		// Upload Video to WhatsApp Storage Server
+		videoUploaded, err := client.Upload(ctx, videoBytes, whatsmeow.MediaVideo)
+		if err != nil {
+			return "", errors.New("Error While Uploading Media to WhatsApp Server")
+		}
+
+		// Compose WhatsApp Proto
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := &waproto.Message{
+			VideoMessage: &waproto.VideoMessage{
+				URL:           proto.String(videoUploaded.URL),
+				DirectPath:    proto.String(videoUploaded.DirectPath),
+				Mimetype:      proto.String(videoType),
+				Caption:       proto.String(videoCaption),
+				FileLength:    proto.Uint64(videoUploaded.FileLength),
+				FileSHA256:    videoUploaded.FileSHA256,
+				FileEncSHA256: videoUploaded.FileEncSHA256,
+				MediaKey:      videoUploaded.MediaKey,
+				ViewOnce:      proto.Bool(isViewOnce),
+			},
+		}
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppSendSticker(ctx context.Context, jid string, rjid string, stickerBytes []byte) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return "", err
+		}
+
+		// Set Chat Presence
+		WhatsAppPresence(jid, true)
+		WhatsAppComposeStatus(jid, remoteJID, true, false)
+		defer func() {
+			WhatsAppComposeStatus(jid, remoteJID, false, false)
+			WhatsAppPresence(jid, false)
+		}()
+
+		// WhatsApp Sticker Should be 512px x 512px WebP Image
+		// So Convert Any Uploaded Image to WebP Format
+		stickerConvDecode, err := imgconv.Decode(bytes.NewReader(stickerBytes))
+		if err != nil {
+			return "", errors.New("Error While Decoding Convert Sticker Stream")
+		}
+
+		// Fit Sticker Inside 512px Box While Keeping Its Aspect Ratio
+		// Then Pad The Remaining Area With Transparent Background
+		stickerConvOption := &imgconv.ResizeOption{Width: 512}
+		if stickerConvDecode.Bounds().Dy() > stickerConvDecode.Bounds().Dx() {
+			stickerConvOption = &imgconv.ResizeOption{Height: 512}
+		}
+
+		stickerConvResize := imgconv.Resize(stickerConvDecode, stickerConvOption)
+		stickerConvCanvas := image.NewNRGBA(image.Rect(0, 0, 512, 512))
+
+		stickerConvOffset := image.Pt((512-stickerConvResize.Bounds().Dx())/2, (512-stickerConvResize.Bounds().Dy())/2)
+		draw.Draw(stickerConvCanvas, stickerConvResize.Bounds().Sub(stickerConvResize.Bounds().Min).Add(stickerConvOffset), stickerConvResize, stickerConvResize.Bounds().Min, draw.Over)
+
+		stickerConvEncode := new(bytes.Buffer)
+
+		err = webp.Encode(stickerConvEncode, stickerConvCanvas)
+		if err != nil {
+			return "", errors.New("Error While Encoding Convert Sticker Stream")
+		}
+
+		stickerBytes = stickerConvEncode.Bytes()
+
+		// Upload Sticker to WhatsApp Storage Server
+		stickerUploaded, err := client.Upload(ctx, stickerBytes, whatsmeow.MediaImage)
+		if err != nil {
+			return "", errors.New("Error While Uploading Media to WhatsApp Server")
+		}
+
+		// Compose WhatsApp Proto
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := &waproto.Message{
+			StickerMessage: &waproto.StickerMessage{
+				URL:           proto.String(stickerUploaded.URL),
+				DirectPath:    proto.String(stickerUploaded.DirectPath),
+				Mimetype:      proto.String("image/webp"),
+				FileLength:    proto.Uint64(stickerUploaded.FileLength),
+				FileSHA256:    stickerUploaded.FileSHA256,
+				FileEncSHA256: stickerUploaded.FileEncSHA256,
+				MediaKey:      stickerUploaded.MediaKey,
+			},
+		}
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppSendContact(ctx context.Context, jid string, rjid string, contactName string, contactNumber string) (string, error) {
+	return WhatsAppSendContacts(ctx, jid, rjid, []VCard{
+		{
+			Name: contactName,
+			Phones: []VCardPhone{
+				{Number: contactNumber},
+			},
+		},
+	})
+}
+
+func WhatsAppSendContacts(ctx context.Context, jid string, rjid string, contacts []VCard) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure Contact List is Not Empty
+		if len(contacts) == 0 {
+			return "", errors.New("WhatsApp Contact List is Empty")
+		}
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return "", err
+		}
+
+		// Set Chat Presence
+		WhatsAppPresence(jid, true)
+		WhatsAppComposeStatus(jid, remoteJID, true, false)
+		defer func() {
+			WhatsAppComposeStatus(jid, remoteJID, false, false)
+			WhatsAppPresence(jid, false)
+		}()
+
+		// Compose Every Contact as vCard Proto
+		var msgContacts []*waproto.ContactMessage
+		for _, contact := range contacts {
+			msgContacts = append(msgContacts, &waproto.ContactMessage{
+				DisplayName: proto.String(contact.Name),
+				Vcard:       proto.String(contact.String()),
+			})
+		}
+
+		// Compose WhatsApp Proto
+		// Single Contact is Sent as Contact Message
+		// While Multiple Contacts are Sent as Contacts Array Message
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+
+		var msgContent *waproto.Message
+		if len(msgContacts) == 1 {
+			msgContent = &waproto.Message{
+				ContactMessage: msgContacts[0],
+			}
+		} else {
+			msgContent = &waproto.Message{
+				ContactsArrayMessage: &waproto.ContactsArrayMessage{
+					DisplayName: proto.String(fmt.Sprintf("%d Contacts", len(msgContacts))),
+					Contacts:    msgContacts,
+				},
+			}
+		}
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppMessageEdit(ctx context.Context, jid string, rjid string, msgid string, message string) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return "", err
+		}
+
+		// Set Chat Presence
+		WhatsAppPresence(jid, true)
+		WhatsAppComposeStatus(jid, remoteJID, true, false)
+		defer func() {
+			WhatsAppComposeStatus(jid, remoteJID, false, false)
+			WhatsAppPresence(jid, false)
+		}()
+
+		// Compose WhatsApp Proto
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := client.BuildEdit(remoteJID, msgid, &waproto.Message{
+			Conversation: proto.String(message),
+		})
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppMessageReact(ctx context.Context, jid string, rjid string, msgid string, sender string, emoji string) (string, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return "", err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return "", err
+		}
+
+		// Reaction Target is Message Sent by This WhatsApp Client
+		// Unless Other Message Sender is Given
+		senderJID := client.Store.ID.ToNonAD()
+		if len(sender) > 0 {
+			senderJID = WhatsAppComposeJID(sender)
+			if senderJID.Server == types.GroupServer {
+				return "", errors.New("WhatsApp Message Sender Should be Personal ID")
+			}
+		}
+
+		// Compose WhatsApp Proto
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := client.BuildReaction(remoteJID, senderJID, msgid, emoji)
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return "", err
+		}
+
+		return msgExtra.ID, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return "", errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppMessageDelete(ctx context.Context, jid string, rjid string, msgid string) error {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return err
+		}
+
+		// Make Sure WhatsApp ID is Registered
+		remoteJID, err := WhatsAppCheckJID(jid, rjid)
+		if err != nil {
+			return err
+		}
+
+		// Compose WhatsApp Proto
+		// Empty Sender Means Revoking Message Sent by This WhatsApp Client
+		msgExtra := whatsmeow.SendRequestExtra{
+			ID: client.GenerateMessageID(),
+		}
+		msgContent := client.BuildRevoke(remoteJID, types.EmptyJID, msgid)
+
+		// Send WhatsApp Message Proto
+		_, err = client.SendMessage(ctx, remoteJID, msgContent, msgExtra)
+
+		// Save Sent Message to Message History
+		WhatsAppHistorySaveSent(jid, remoteJID, msgExtra.ID, msgContent, err)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return errors.New("WhatsApp Client is not Valid")
+}
+
+func WhatsAppGroupGet(jid string) ([]types.GroupInfo, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get Joined Group List
+		groups, err := client.GetJoinedGroups()
+		if err != nil {
+			return nil, err
+		}
+
+		// Put Group Information in List
+		var gids []types.GroupInfo
+		for _, group := range groups {
+			gids = append(gids, *group)
+		}
+
+		// Return Group Information List
+		return gids, nil
+	}
+
+	// Return Error WhatsApp Client is not Valid
+	return nil, errors.New("WhatsApp Client is not Valid")
+}
+
+// WhatsAppGroupList Get Joined Group Summary Page Filtered by Query
+// Groups Are Sorted by Group ID and Next Page Can Be Requested Using Next Cursor as After Cursor
+func WhatsAppGroupList(jid string, query GroupQuery) (*GroupPage, error) {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
+		var err error
+
+		// Make Sure WhatsApp Client is OK
+		err = WhatsAppIsClientOK(jid)
+		if err != nil {
+			return nil, err
+		}
+
+		if query.Limit <= 0 || query.Limit > 200 {
+			query.Limit = 50
+		}
+
+		// Get Joined Group List
+		groups, err := client.GetJoinedGroups()
+		if err != nil {
+			return nil, err
+		}
+
+		// Sort Group by Group ID So Cursor Stays Stable Between Requests
+		sort.Slice(groups, func(i, j int) bool {
+			return groups[i].JID.String() < groups[j].JID.String()
+		})
+
+		queryName := strings.ToLower(strings.TrimSpace(query.Query))
+
+		// Put Filtered Group Information in Page
+		page := &GroupPage{
+			Groups: []GroupSummary{},
+		}
+
+		for _, group := range groups {
+			if len(query.After) > 0 && group.JID.String() <= query.After {
+				continue
+			}
+
+			if len(queryName) > 0 && !strings.Contains(strings.ToLower(group.Name), queryName) {
+				continue
+			}
+
+			// Community Hierarchy Can be Browsed by Filtering Groups Linked to Parent
+			if len(query.Parent) > 0 && group.LinkedParentJID.String() != query.Parent {
+				continue
+			}
+
+			isAdmin := whatsAppGroupIsAdmin(client, group)
+			if query.IsAdmin && !isAdmin {
+				continue
+			}
+
+			if len(page.Groups) == query.Limit {
+				page.NextCursor = page.Groups[query.Limit-1].GID
+				break
+			}
+
+			page.Groups = append(page.Groups, GroupSummary{
+				GroupPreview: *whatsAppGroupPreview(group),
+				IsAdmin:      isAdmin,
+			})
+		}
+
+		// Return Group Information Page
+		return page, nil
 	}
 
 	// Return Error WhatsApp Client is not Valid
@@ -623,7 +1582,8 @@ func WhatsAppGroupGet(jid string) ([]types.GroupInfo, error) {
 }
 
 func WhatsAppGroupJoin(jid string, link string) (string, error) {
-	if WhatsAppClient[jid] != nil {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		var err error
 
 		// Make Sure WhatsApp Client is OK
@@ -633,7 +1593,7 @@ func WhatsAppGroupJoin(jid string, link string) (string, error) {
 		}
 
 		// Join Group By Invitation Link
-		gid, err := WhatsAppClient[jid].JoinGroupWithLink(link)
+		gid, err := client.JoinGroupWithLink(link)
 		if err != nil {
 			return "", err
 		}
@@ -647,7 +1607,8 @@ func WhatsAppGroupJoin(jid string, link string) (string, error) {
 }
 
 func WhatsAppGroupLeave(jid string, gjid string) error {
-	if WhatsAppClient[jid] != nil {
+	client := WhatsAppSession.Get(jid)
+	if client != nil {
 		var err error
 
 		// Make Sure WhatsApp Client is OK
@@ -668,7 +1629,7 @@ func WhatsAppGroupLeave(jid string, gjid string) error {
 		}
 
 		// Leave Group By Group ID
-		return WhatsAppClient[jid].LeaveGroup(groupJID)
+		return client.LeaveGroup(groupJID)
 	}
 
 	// Return Error WhatsApp Client is not Valid